@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/export"
+)
+
+// runExport implements the export subcommand, which writes Terraform configuration and import blocks
+// for the roles, policies, service accounts and tokens of a permission system
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-authzed export --permission-system ps-... [--out dir]\n\n")
+		fmt.Fprintf(flags.Output(), "The API token is read from AUTHZED_TOKEN.\n\n")
+		flags.PrintDefaults()
+	}
+
+	permissionsSystemID := flags.String("permission-system", "", "ID of the permission system to export")
+	outDir := flags.String("out", ".", "directory to write the generated files to")
+	endpoint := flags.String("endpoint", os.Getenv("AUTHZED_HOST"), "host address of the AuthZed Cloud API (defaults to AUTHZED_HOST)")
+	apiVersion := flags.String("api-version", os.Getenv("AUTHZED_API_VERSION"), "version of the API to use (defaults to AUTHZED_API_VERSION, or 25r1)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if *permissionsSystemID == "" {
+		flags.Usage()
+		return errors.New("--permission-system is required")
+	}
+	if *endpoint == "" {
+		return errors.New("--endpoint or AUTHZED_HOST is required")
+	}
+	token := os.Getenv("AUTHZED_TOKEN")
+	if token == "" {
+		return errors.New("AUTHZED_TOKEN is required")
+	}
+
+	cloudClient := client.NewCloudClient(&client.CloudClientConfig{
+		Host:       *endpoint,
+		Token:      token,
+		APIVersion: *apiVersion,
+	})
+
+	snapshot, err := export.Read(ctx, cloudClient, *permissionsSystemID)
+	if err != nil {
+		return err
+	}
+
+	paths, err := export.Write(*outDir, export.Generate(snapshot))
+	if err != nil {
+		return err
+	}
+
+	tokens := 0
+	for _, serviceAccountTokens := range snapshot.Tokens {
+		tokens += len(serviceAccountTokens)
+	}
+	fmt.Printf("Exported %d roles, %d policies, %d service accounts and %d tokens of %s:\n",
+		len(snapshot.Roles), len(snapshot.Policies), len(snapshot.ServiceAccounts), tokens, *permissionsSystemID)
+	for _, path := range paths {
+		fmt.Printf("  %s\n", path)
+	}
+	fmt.Println("\nRun terraform plan to review the imports.")
+	return nil
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCloudClient_OnBackOff(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		message    string
+		expected   bool
+	}{
+		{
+			name:       "FGAM configuration conflict",
+			statusCode: http.StatusConflict,
+			message:    "the restricted API access configuration has changed",
+			expected:   true,
+		},
+		{
+			name:       "Rate limited",
+			statusCode: http.StatusTooManyRequests,
+			message:    "too many requests",
+			expected:   true,
+		},
+		{
+			name:       "Other conflict",
+			statusCode: http.StatusConflict,
+			message:    "a token with this name already exists",
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_ = json.NewEncoder(w).Encode(map[string]string{"message": tt.message})
+			}))
+			defer server.Close()
+
+			var notified string
+			c := NewCloudClient(&CloudClientConfig{Host: server.URL, Token: "test-token"})
+			c.OnBackOff = func(_ context.Context, permissionsSystemID string, _ *APIError) {
+				notified = permissionsSystemID
+			}
+
+			req, err := c.NewRequest(http.MethodPost, "/ps/ps-123/access/roles", nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := notified == "ps-123"; got != tt.expected {
+				t.Errorf("Expected back off notification %v, got %q", tt.expected, notified)
+			}
+
+			// The body must still be readable by callers
+			if apiErr := NewAPIError(resp); apiErr.Message != tt.message {
+				t.Errorf("Expected message %q, got %q", tt.message, apiErr.Message)
+			}
+		})
+	}
+}
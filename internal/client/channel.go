@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// ListChannelsAndVersions retrieves the update channels and SpiceDB versions available
+// for the given datastore kind. An empty kind lists channels for all datastores.
+func (c *CloudClient) ListChannelsAndVersions(ctx context.Context, dataStoreKind string) ([]models.SpiceDBChannel, error) {
+	path := "/channels"
+	if dataStoreKind != "" {
+		path = fmt.Sprintf("%s?dataStoreKind=%s", path, url.QueryEscape(dataStoreKind))
+	}
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var channelsResp models.ListChannelsAndVersionsResponse
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&channelsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return channelsResp.Channels, nil
+}
@@ -21,6 +21,9 @@ type CloudClient struct {
 	APIVersion    string
 	HTTPClient    *http.Client
 	DeleteTimeout time.Duration
+	// OnBackOff, when set, is called for every response that asks to reduce concurrency on a
+	// permission system, including those the client retries internally
+	OnBackOff func(ctx context.Context, permissionsSystemID string, err *APIError)
 }
 
 // CloudClientConfig represents the config for the Cloud API client
@@ -154,6 +157,10 @@ func (c *CloudClient) Do(req *http.Request) (*ResponseWithETag, error) {
 		return nil, err
 	}
 
+	if c.OnBackOff != nil && (resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusTooManyRequests) {
+		c.notifyBackOff(req, resp)
+	}
+
 	// Extract the ETag per OpenAPI specification
 	etag := resp.Header.Get("ETag")
 
@@ -171,6 +178,32 @@ func (c *CloudClient) Do(req *http.Request) (*ResponseWithETag, error) {
 	}, nil
 }
 
+// notifyBackOff calls OnBackOff if the response asks to reduce concurrency. The body is buffered so that
+// callers can still read it.
+func (c *CloudClient) notifyBackOff(req *http.Request, resp *http.Response) {
+	body := resp.Body
+	apiErr := NewAPIError(&HTTPResponseWrapper{Response: resp})
+	_ = body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(apiErr.Body))
+
+	permissionsSystemID := permissionsSystemIDFromPath(req.URL.Path)
+	if permissionsSystemID == "" || !apiErr.ShouldBackOff() {
+		return
+	}
+	c.OnBackOff(req.Context(), permissionsSystemID, apiErr)
+}
+
+// permissionsSystemIDFromPath returns the permission system ID of a /ps/{id}/... path, or an empty string
+func permissionsSystemIDFromPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == "ps" {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
 // DeleteResource deletes a resource
 func (c *CloudClient) DeleteResource(endpoint string) error {
 	req, err := c.NewRequest(http.MethodDelete, endpoint, nil)
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// DatastoreWithETag represents a datastore resource with its ETag
+type DatastoreWithETag struct {
+	Datastore *models.Datastore
+	ETag      string
+}
+
+// GetID returns the datastore's ID
+func (d *DatastoreWithETag) GetID() string {
+	return d.Datastore.ID
+}
+
+// GetETag returns the ETag value
+func (d *DatastoreWithETag) GetETag() string {
+	return d.ETag
+}
+
+// SetETag sets the ETag value
+func (d *DatastoreWithETag) SetETag(etag string) {
+	d.ETag = etag
+}
+
+// GetResource returns the underlying datastore
+func (d *DatastoreWithETag) GetResource() any {
+	return d.Datastore
+}
+
+// ListDatastores retrieves all datastore instances
+func (c *CloudClient) ListDatastores() ([]models.Datastore, error) {
+	req, err := c.NewRequest(http.MethodGet, "/datastores", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var datastores []models.Datastore
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&datastores); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return datastores, nil
+}
+
+// GetDatastore retrieves a datastore by ID
+func (c *CloudClient) GetDatastore(ctx context.Context, datastoreID string) (*DatastoreWithETag, error) {
+	path := fmt.Sprintf("/datastores/%s", datastoreID)
+
+	var datastoreResp models.DatastoreResponse
+	resource, err := c.GetResourceWithFactoryWithContext(ctx, path, &datastoreResp, NewDatastoreResource)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.(*DatastoreWithETag), nil
+}
+
+// CreateDatastore provisions a new datastore from a template
+func (c *CloudClient) CreateDatastore(ctx context.Context, datastore *models.CreateDatastoreRequest) (*DatastoreWithETag, error) {
+	path := "/datastores"
+
+	// Use retry logic with exponential backoff
+	retryConfig := DefaultRetryConfig()
+
+	createOperation := func() (*ResponseWithETag, error) {
+		req, err := c.NewRequest(http.MethodPost, path, datastore)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(ctx)
+
+		respWithETag, err := c.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		// Treat 409/429/5xx as retryable by converting to APIError
+		code := respWithETag.Response.StatusCode
+		if code == http.StatusConflict || code == http.StatusTooManyRequests || code >= 500 {
+			return nil, NewAPIError(respWithETag)
+		}
+
+		return respWithETag, nil
+	}
+
+	// Datastores are not versioned with ETags, retries simply repeat the request
+	getLatestETag := func() (string, error) {
+		return "", nil
+	}
+
+	createWithETag := func(string) (*ResponseWithETag, error) {
+		return createOperation()
+	}
+
+	respWithETag, err := retryConfig.RetryWithExponentialBackoffLegacy(
+		ctx,
+		createOperation,
+		getLatestETag,
+		createWithETag,
+		"datastore create",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	return decodeDatastoreResponse(respWithETag)
+}
+
+// UpdateDatastore updates the name or cluster specification of a datastore using the PUT method
+func (c *CloudClient) UpdateDatastore(ctx context.Context, datastoreID string, update *models.UpdateDatastoreRequest) (*DatastoreWithETag, error) {
+	path := fmt.Sprintf("/datastores/%s", datastoreID)
+
+	updateOperation := func() (*ResponseWithETag, error) {
+		req, err := c.NewRequest(http.MethodPut, path, update)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req = req.WithContext(ctx)
+
+		respWithETag, err := c.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		return respWithETag, nil
+	}
+
+	// Use retry logic with exponential backoff
+	retryConfig := DefaultRetryConfig()
+	respWithETag, err := retryConfig.RetryWithExponentialBackoffLegacy(
+		ctx,
+		updateOperation,
+		func() (string, error) { return "", nil },
+		func(string) (*ResponseWithETag, error) { return updateOperation() },
+		"datastore update",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	return decodeDatastoreResponse(respWithETag)
+}
+
+// DeleteDatastore deletes a datastore by its ID. The API accepts the request
+// asynchronously, so this blocks until the datastore is gone.
+func (c *CloudClient) DeleteDatastore(datastoreID string) error {
+	path := fmt.Sprintf("/datastores/%s", datastoreID)
+	return c.DeleteResource(path)
+}
+
+// decodeDatastoreResponse decodes the datastore envelope returned by create and update
+func decodeDatastoreResponse(respWithETag *ResponseWithETag) (*DatastoreWithETag, error) {
+	body, err := io.ReadAll(respWithETag.Response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var datastoreResp models.DatastoreResponse
+	if err := json.Unmarshal(body, &datastoreResp); err != nil {
+		return nil, fmt.Errorf("failed to decode datastore response: %w (response body: %s)", err, string(body))
+	}
+
+	if datastoreResp.Datastore.ID == "" {
+		return nil, fmt.Errorf("datastore response missing required field 'id' (response body: %s)", string(body))
+	}
+
+	return &DatastoreWithETag{
+		Datastore: &datastoreResp.Datastore,
+		ETag:      respWithETag.ETag,
+	}, nil
+}
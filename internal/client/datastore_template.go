@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// ListDatastoreTemplates retrieves all datastore templates available for provisioning
+func (c *CloudClient) ListDatastoreTemplates(ctx context.Context) ([]models.DatastoreTemplate, error) {
+	req, err := c.NewRequest(http.MethodGet, "/datastore-templates", nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var templatesResp models.ListDatastoreTemplatesResponse
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&templatesResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return templatesResp.Templates, nil
+}
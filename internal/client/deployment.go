@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// ListDeployments retrieves all deployments of a permission system
+func (c *CloudClient) ListDeployments(ctx context.Context, permissionsSystemID string) ([]models.Deployment, error) {
+	path := fmt.Sprintf("/ps/%s/deployments", permissionsSystemID)
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var deployments []models.Deployment
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&deployments); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return deployments, nil
+}
@@ -44,6 +44,13 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("API error (status %d)", e.StatusCode)
 }
 
+// ShouldBackOff reports whether the error asks callers to reduce their concurrency: a 429, or a 409
+// caused by a concurrent change to the FGAM configuration of the permission system
+func (e *APIError) ShouldBackOff() bool {
+	return e.StatusCode == http.StatusTooManyRequests ||
+		(e.StatusCode == http.StatusConflict && containsFGAMConfigConflict(e.Message))
+}
+
 // containsFGAMConfigConflict checks if the error message indicates a configuration conflict
 func containsFGAMConfigConflict(message string) bool {
 	lowerMessage := strings.ToLower(message)
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// ListExternalMetricsTokens retrieves the external metrics tokens of a permission system
+func (c *CloudClient) ListExternalMetricsTokens(ctx context.Context, permissionsSystemID string) ([]models.ExternalMetricsTokenListItem, error) {
+	path := fmt.Sprintf("/ps/%s/externalmetrics/tokens", permissionsSystemID)
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var listResp models.ListExternalMetricsTokensResponse
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return listResp.Tokens, nil
+}
+
+// CreateExternalMetricsToken creates a new external metrics token. The secret is only returned once.
+func (c *CloudClient) CreateExternalMetricsToken(ctx context.Context, permissionsSystemID string) (*models.ExternalMetricsToken, error) {
+	path := fmt.Sprintf("/ps/%s/externalmetrics/tokens", permissionsSystemID)
+
+	req, err := c.NewRequest(http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var token models.ExternalMetricsToken
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if token.ID == "" || token.Secret == "" {
+		return nil, fmt.Errorf("external metrics token response missing required fields 'id' or 'secret'")
+	}
+
+	return &token, nil
+}
+
+// RotateExternalMetricsToken replaces the secret of an existing external metrics token and returns the new secret
+func (c *CloudClient) RotateExternalMetricsToken(ctx context.Context, permissionsSystemID, tokenID string) (string, error) {
+	path := fmt.Sprintf("/ps/%s/externalmetrics/tokens/%s", permissionsSystemID, tokenID)
+
+	req, err := c.NewRequest(http.MethodPut, path, nil)
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return "", NewAPIError(respWithETag)
+	}
+
+	var rotateResp models.RotateExternalMetricsTokenResponse
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&rotateResp); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if rotateResp.Secret == "" {
+		return "", fmt.Errorf("rotate external metrics token response missing required field 'secret'")
+	}
+
+	return rotateResp.Secret, nil
+}
@@ -67,3 +67,15 @@ func NewPermissionsSystemResource(decoded any, etag string) Resource {
 		ETag:              etag,
 	}
 }
+
+// NewDatastoreResource creates a DatastoreWithETag Resource from a datastore response envelope
+func NewDatastoreResource(decoded any, etag string) Resource {
+	datastoreResp, ok := decoded.(*models.DatastoreResponse)
+	if !ok {
+		panic("Invalid type for Datastore")
+	}
+	return &DatastoreWithETag{
+		Datastore: &datastoreResp.Datastore,
+		ETag:      etag,
+	}
+}
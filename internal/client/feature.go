@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// Permission system features are exposed under /ps/{id}/features/{feature}
+const (
+	FeatureAuditLog      = "audit-log"
+	FeatureDatadogExport = "datadog-export"
+)
+
+// GetAuditLogFeature retrieves the audit log feature configuration of a permission system
+func (c *CloudClient) GetAuditLogFeature(ctx context.Context, permissionsSystemID string) (*models.AuditLogFeature, error) {
+	var feature models.AuditLogFeature
+	if err := c.getFeature(ctx, permissionsSystemID, FeatureAuditLog, &feature); err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+// SetAuditLogFeature configures and enables or disables the audit log feature of a permission system
+func (c *CloudClient) SetAuditLogFeature(ctx context.Context, permissionsSystemID string, request *models.SetAuditLogFeatureRequest) (*models.AuditLogFeature, error) {
+	var feature models.AuditLogFeature
+	if err := c.setFeature(ctx, permissionsSystemID, FeatureAuditLog, request, &feature); err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+// GetDatadogExportFeature retrieves the Datadog export feature configuration of a permission system
+func (c *CloudClient) GetDatadogExportFeature(ctx context.Context, permissionsSystemID string) (*models.DatadogExportFeature, error) {
+	var feature models.DatadogExportFeature
+	if err := c.getFeature(ctx, permissionsSystemID, FeatureDatadogExport, &feature); err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+// SetDatadogExportFeature configures and enables the Datadog export feature of a permission system
+func (c *CloudClient) SetDatadogExportFeature(ctx context.Context, permissionsSystemID string, request *models.SetDatadogExportFeatureRequest) (*models.DatadogExportFeature, error) {
+	var feature models.DatadogExportFeature
+	if err := c.setFeature(ctx, permissionsSystemID, FeatureDatadogExport, request, &feature); err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+// getFeature retrieves a permission system feature and decodes it into dest
+func (c *CloudClient) getFeature(ctx context.Context, permissionsSystemID, feature string, dest any) error {
+	path := fmt.Sprintf("/ps/%s/features/%s", permissionsSystemID, feature)
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return NewAPIError(respWithETag)
+	}
+
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(dest); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// setFeature sends a feature configuration with PUT and decodes the response into dest.
+// Features are not versioned with ETags, retries simply repeat the request.
+func (c *CloudClient) setFeature(ctx context.Context, permissionsSystemID, feature string, body, dest any) error {
+	path := fmt.Sprintf("/ps/%s/features/%s", permissionsSystemID, feature)
+
+	setOperation := func() (*ResponseWithETag, error) {
+		req, err := c.NewRequest(http.MethodPut, path, body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req = req.WithContext(ctx)
+
+		respWithETag, err := c.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		return respWithETag, nil
+	}
+
+	// Use retry logic with exponential backoff
+	retryConfig := DefaultRetryConfig()
+	respWithETag, err := retryConfig.RetryWithExponentialBackoffLegacy(
+		ctx,
+		setOperation,
+		func() (string, error) { return "", nil },
+		func(string) (*ResponseWithETag, error) { return setOperation() },
+		fmt.Sprintf("%s feature update", feature),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return NewAPIError(respWithETag)
+	}
+
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(dest); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
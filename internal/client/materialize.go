@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// ListMaterializeClusters retrieves the Materialize clusters of a permission system,
+// optionally limited to a single deployment
+func (c *CloudClient) ListMaterializeClusters(ctx context.Context, permissionsSystemID, deploymentID string) ([]models.MaterializeCluster, error) {
+	path := fmt.Sprintf("/ps/%s/materialize", permissionsSystemID)
+	if deploymentID != "" {
+		path = fmt.Sprintf("%s?deploymentID=%s", path, url.QueryEscape(deploymentID))
+	}
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var clustersResp models.ListMaterializeClustersResponse
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&clustersResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return clustersResp.Clusters, nil
+}
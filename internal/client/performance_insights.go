@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// GetPerformanceInsightsTopK retrieves the most expensive API call shapes of a permission system.
+// Empty or zero arguments are omitted so the API defaults apply.
+func (c *CloudClient) GetPerformanceInsightsTopK(ctx context.Context, permissionsSystemID, period string, count int64, sortBy string) ([]models.APIShapeMetrics, error) {
+	query := url.Values{}
+	if period != "" {
+		query.Set("period", period)
+	}
+	if count > 0 {
+		query.Set("count", strconv.FormatInt(count, 10))
+	}
+	if sortBy != "" {
+		query.Set("sortBy", sortBy)
+	}
+
+	path := fmt.Sprintf("/ps/%s/perf-insights/topk", permissionsSystemID)
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var topKResp models.PerfInsightsTopKResponse
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&topKResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return topKResp.Shapes, nil
+}
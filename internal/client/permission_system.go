@@ -105,3 +105,94 @@ func (c *CloudClient) GetPermissionsSystem(ctx context.Context, permissionsSyste
 
 	return resource.(*PermissionsSystemWithETag), nil
 }
+
+// UpdatePermissionsSystem updates the settings of an existing permission system using the PUT method
+func (c *CloudClient) UpdatePermissionsSystem(ctx context.Context, permissionsSystemID string, update *models.UpdatePermissionsSystemRequest, etag string) (*PermissionsSystemWithETag, error) {
+	path := fmt.Sprintf("/ps/%s", permissionsSystemID)
+
+	// Define a function to get the latest ETag
+	getLatestETag := func() (string, error) {
+		getReq, err := c.NewRequest(http.MethodGet, path, nil)
+		if err != nil {
+			return "", fmt.Errorf("failed to create GET request: %w", err)
+		}
+
+		getResp, err := c.Do(getReq)
+		if err != nil {
+			return "", fmt.Errorf("failed to send GET request: %w", err)
+		}
+		defer func() {
+			if getResp.Response.Body != nil {
+				_ = getResp.Response.Body.Close()
+			}
+		}()
+
+		if getResp.Response.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("failed to get latest ETag, status: %d", getResp.Response.StatusCode)
+		}
+
+		return getResp.ETag, nil
+	}
+
+	// Try update with provided ETag
+	updateWithETag := func(currentETag string) (*ResponseWithETag, error) {
+		req, err := c.NewRequest(http.MethodPut, path, update)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req = req.WithContext(ctx)
+
+		// Only set If-Match header if we have a non-empty ETag
+		if currentETag != "" {
+			req.Header.Set("If-Match", currentETag)
+		}
+
+		respWithETag, err := c.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		return respWithETag, nil
+	}
+
+	// Use retry logic with exponential backoff
+	retryConfig := DefaultRetryConfig()
+	respWithETag, err := retryConfig.RetryWithExponentialBackoffLegacy(
+		ctx,
+		func() (*ResponseWithETag, error) {
+			return updateWithETag(etag)
+		},
+		getLatestETag,
+		updateWithETag,
+		"permission system update",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if respWithETag.Response.Body != nil {
+			_ = respWithETag.Response.Body.Close()
+		}
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var updated models.PermissionsSystem
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &PermissionsSystemWithETag{
+		PermissionsSystem: &updated,
+		ETag:              respWithETag.ETag,
+	}, nil
+}
+
+// DeletePermissionsSystem deletes a permission system by its ID. The API accepts the
+// request asynchronously, so this blocks until the permission system is gone.
+func (c *CloudClient) DeletePermissionsSystem(permissionsSystemID string) error {
+	path := fmt.Sprintf("/ps/%s", permissionsSystemID)
+	return c.DeleteResource(path)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// ListRegions retrieves all regions available for SpiceDB clusters
+func (c *CloudClient) ListRegions(ctx context.Context) ([]models.Region, error) {
+	req, err := c.NewRequest(http.MethodGet, "/regions", nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	respWithETag, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ignore the error
+		_ = respWithETag.Response.Body.Close()
+	}()
+
+	if respWithETag.Response.StatusCode != http.StatusOK {
+		return nil, NewAPIError(respWithETag)
+	}
+
+	var regions []models.Region
+	if err := json.NewDecoder(respWithETag.Response.Body).Decode(&regions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return regions, nil
+}
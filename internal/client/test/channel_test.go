@@ -0,0 +1,53 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-authzed/internal/client"
+)
+
+func TestListChannelsAndVersions(t *testing.T) {
+	var lastKind string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/channels", r.URL.Path)
+		lastKind = r.URL.Query().Get("dataStoreKind")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"channels": [{
+			"name": "stable",
+			"displayName": "Stable",
+			"versions": [
+				{"version": "v1.41.0", "displayName": "v1.41.0", "supportedAPIs": ["authzed.api.v1.PermissionsService"], "supportedFeatureNames": ["AuditLog"]},
+				{"version": "v1.40.0", "displayName": "v1.40.0"}
+			]
+		}]}`))
+		if err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewCloudClient(&client.CloudClientConfig{
+		Host:       server.URL,
+		Token:      "test-token",
+		APIVersion: "v1",
+		Timeout:    client.DefaultTimeout,
+	})
+
+	channels, err := c.ListChannelsAndVersions(context.Background(), "postgres")
+	require.NoError(t, err)
+	assert.Equal(t, "postgres", lastKind)
+	require.Len(t, channels, 1)
+	require.Len(t, channels[0].Versions, 2)
+	assert.Equal(t, "v1.41.0", channels[0].Versions[0].Version)
+	assert.Equal(t, []string{"authzed.api.v1.PermissionsService"}, channels[0].Versions[0].SupportedAPIs)
+
+	_, err = c.ListChannelsAndVersions(context.Background(), "")
+	require.NoError(t, err)
+	assert.Empty(t, lastKind)
+}
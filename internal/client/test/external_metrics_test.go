@@ -0,0 +1,64 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-authzed/internal/client"
+)
+
+func TestExternalMetricsTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/ps/ps-test123/externalmetrics/tokens":
+			w.WriteHeader(http.StatusCreated)
+			body = `{"id": "em-test123", "permissionsSystemID": "ps-test123", "secret": "secret-1"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/ps/ps-test123/externalmetrics/tokens":
+			w.WriteHeader(http.StatusOK)
+			body = `{"tokens": [{"id": "em-test123", "hash": "abc123"}]}`
+		case r.Method == http.MethodPut && r.URL.Path == "/ps/ps-test123/externalmetrics/tokens/em-test123":
+			w.WriteHeader(http.StatusOK)
+			body = `{"secret": "secret-2"}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewCloudClient(&client.CloudClientConfig{
+		Host:       server.URL,
+		Token:      "test-token",
+		APIVersion: "v1",
+		Timeout:    client.DefaultTimeout,
+	})
+	ctx := context.Background()
+
+	token, err := c.CreateExternalMetricsToken(ctx, "ps-test123")
+	require.NoError(t, err)
+	assert.Equal(t, "em-test123", token.ID)
+	assert.Equal(t, "secret-1", token.Secret)
+
+	tokens, err := c.ListExternalMetricsTokens(ctx, "ps-test123")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "abc123", tokens[0].Hash)
+
+	secret, err := c.RotateExternalMetricsToken(ctx, "ps-test123", "em-test123")
+	require.NoError(t, err)
+	assert.Equal(t, "secret-2", secret)
+
+	_, err = c.RotateExternalMetricsToken(ctx, "ps-test123", "em-missing")
+	apiErr := &client.APIError{}
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestAuditLogFeature(t *testing.T) {
+	var lastRequest models.SetAuditLogFeatureRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ps/ps-test123/features/audit-log" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{
+				"id": "AuditLog",
+				"displayName": "Audit Log",
+				"enabled": true,
+				"config": {
+					"targetType": "aws-kinesis",
+					"streamName": "audit",
+					"disabledOnMethods": ["CheckPermission"]
+				}
+			}`))
+			if err != nil {
+				t.Errorf("Failed to write response: %v", err)
+			}
+
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&lastRequest); err != nil {
+				t.Errorf("Failed to decode request: %v", err)
+			}
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(models.AuditLogFeature{
+				ID:      "AuditLog",
+				Enabled: lastRequest.Enabled,
+				Config:  lastRequest.Config,
+			}); err != nil {
+				t.Errorf("Failed to write response: %v", err)
+			}
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewCloudClient(&client.CloudClientConfig{
+		Host:       server.URL,
+		Token:      "test-token",
+		APIVersion: "v1",
+		Timeout:    client.DefaultTimeout,
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		feature, err := c.GetAuditLogFeature(context.Background(), "ps-test123")
+		require.NoError(t, err)
+		assert.True(t, feature.Enabled)
+		require.NotNil(t, feature.Config)
+		assert.Equal(t, "aws-kinesis", feature.Config.TargetType)
+		assert.Equal(t, []string{"CheckPermission"}, feature.Config.DisabledOnMethods)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		feature, err := c.SetAuditLogFeature(context.Background(), "ps-test123", &models.SetAuditLogFeatureRequest{
+			Enabled: false,
+			Config:  &models.AuditLogFeatureConfig{TargetType: "aws-s3", StreamName: "audit"},
+		})
+		require.NoError(t, err)
+		assert.False(t, lastRequest.Enabled)
+		assert.False(t, feature.Enabled)
+		assert.Equal(t, "aws-s3", feature.Config.TargetType)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := c.GetAuditLogFeature(context.Background(), "ps-missing")
+		apiErr := &client.APIError{}
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	})
+}
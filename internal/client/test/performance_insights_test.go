@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-authzed/internal/client"
+)
+
+func TestGetPerformanceInsightsTopK(t *testing.T) {
+	var lastQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/ps/ps-test123/perf-insights/topk", r.URL.Path)
+		lastQuery = r.URL.Query()
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"shapes": [{
+			"shapeID": "shape-1",
+			"apiName": "CheckPermission",
+			"apiCategory": "check",
+			"apiIndex": 1,
+			"count": 1200,
+			"shapeFields": {"resource_type": "document", "name": "view"},
+			"orderedShapeFields": ["resource_type", "name"],
+			"p50LatencySeconds": 0.004,
+			"p95LatencySeconds": 0.012,
+			"p99LatencySeconds": 0.031
+		}, {
+			"shapeID": "shape-2",
+			"apiName": "LookupResources",
+			"apiCategory": "lookup"
+		}]}`))
+		if err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewCloudClient(&client.CloudClientConfig{
+		Host:       server.URL,
+		Token:      "test-token",
+		APIVersion: "v1",
+		Timeout:    client.DefaultTimeout,
+	})
+
+	shapes, err := c.GetPerformanceInsightsTopK(context.Background(), "ps-test123", "24h", 5, "p99")
+	require.NoError(t, err)
+	assert.Equal(t, "24h", lastQuery.Get("period"))
+	assert.Equal(t, "5", lastQuery.Get("count"))
+	assert.Equal(t, "p99", lastQuery.Get("sortBy"))
+	require.Len(t, shapes, 2)
+	assert.Equal(t, "CheckPermission", shapes[0].APIName)
+	assert.Equal(t, "document", shapes[0].ShapeFields["resource_type"])
+	require.NotNil(t, shapes[0].P99LatencySeconds)
+	assert.InDelta(t, 0.031, *shapes[0].P99LatencySeconds, 1e-9)
+	assert.Nil(t, shapes[1].Count)
+	assert.Nil(t, shapes[1].P99LatencySeconds)
+
+	_, err = c.GetPerformanceInsightsTopK(context.Background(), "ps-test123", "", 0, "")
+	require.NoError(t, err)
+	assert.Empty(t, lastQuery)
+}
@@ -0,0 +1,328 @@
+// Package export generates Terraform configuration and import blocks for the access management
+// objects of an existing permission system, so that systems built in the console can be brought
+// under Terraform.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+// Snapshot holds the access management objects of a permission system
+type Snapshot struct {
+	PermissionsSystemID string
+	Roles               []models.Role
+	Policies            []models.Policy
+	ServiceAccounts     []models.ServiceAccount
+	// Tokens holds the tokens of each service account, by service account ID
+	Tokens map[string][]models.TokenRequest
+}
+
+// Read lists the roles, policies, service accounts and tokens of a permission system
+func Read(_ context.Context, c *client.CloudClient, permissionsSystemID string) (*Snapshot, error) {
+	roles, err := c.ListRoles(permissionsSystemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	policies, err := c.ListPolicies(permissionsSystemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	serviceAccounts, err := c.ListServiceAccounts(permissionsSystemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	tokens := make(map[string][]models.TokenRequest, len(serviceAccounts))
+	for _, serviceAccount := range serviceAccounts {
+		serviceAccountTokens, err := c.ListTokens(permissionsSystemID, serviceAccount.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tokens of service account %s: %w", serviceAccount.ID, err)
+		}
+		tokens[serviceAccount.ID] = serviceAccountTokens
+	}
+
+	return &Snapshot{
+		PermissionsSystemID: permissionsSystemID,
+		Roles:               roles,
+		Policies:            policies,
+		ServiceAccounts:     serviceAccounts,
+		Tokens:              tokens,
+	}, nil
+}
+
+// Generate returns the contents of the generated files, by file name. Resources are named after
+// the objects, and policies and tokens reference roles and service accounts by resource address.
+func Generate(snapshot *Snapshot) map[string][]byte {
+	g := &generator{
+		snapshot:        snapshot,
+		names:           make(map[string]bool),
+		roles:           make(map[string]string),
+		serviceAccounts: make(map[string]string),
+		imports:         hclwrite.NewEmptyFile(),
+	}
+
+	files := map[string][]byte{
+		"main.tf": g.main(),
+	}
+	// Roles and service accounts go first, so that policies and tokens can reference them
+	files["roles.tf"] = g.roleFile()
+	files["service_accounts.tf"] = g.serviceAccountFile()
+	files["policies.tf"] = g.policyFile()
+	files["tokens.tf"] = g.tokenFile()
+	files["imports.tf"] = g.importFile()
+
+	return files
+}
+
+// Write writes the generated files to dir, refusing to overwrite existing files. It returns the paths of the written files.
+func Write(dir string, files map[string][]byte) ([]string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return nil, fmt.Errorf("%s already exists, export to an empty directory", filepath.Join(dir, name))
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, files[name], 0o644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// generator builds the files of a snapshot, keeping track of the resource names in use
+type generator struct {
+	snapshot *Snapshot
+	// names holds the addresses already in use
+	names map[string]bool
+	// roles and serviceAccounts map object IDs to resource names
+	roles           map[string]string
+	serviceAccounts map[string]string
+	imports         *hclwrite.File
+}
+
+func (g *generator) main() []byte {
+	f := g.newFile()
+	locals := f.Body().AppendNewBlock("locals", nil).Body()
+	locals.SetAttributeValue("permission_system_id", cty.StringVal(g.snapshot.PermissionsSystemID))
+	return f.Bytes()
+}
+
+func (g *generator) roleFile() []byte {
+	f := g.newFile()
+	roles := append([]models.Role(nil), g.snapshot.Roles...)
+	sort.SliceStable(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	for _, role := range roles {
+		name := g.resourceName("authzed_role", role.Name)
+		g.roles[role.ID] = name
+
+		body := g.appendResource(f, "authzed_role", name)
+		body.SetAttributeValue("name", cty.StringVal(role.Name))
+		setDescription(body, role.Description)
+		body.SetAttributeTraversal("permission_system_id", localPermissionsSystemID())
+
+		permissions := make(map[string]cty.Value, len(role.Permissions))
+		for key, expression := range role.Permissions {
+			permissions[key] = cty.StringVal(expression)
+		}
+		if len(permissions) == 0 {
+			body.SetAttributeValue("permissions", cty.MapValEmpty(cty.String))
+		} else {
+			body.SetAttributeValue("permissions", cty.MapVal(permissions))
+		}
+
+		g.appendImport("authzed_role", name, g.snapshot.PermissionsSystemID+":"+role.ID)
+	}
+	return f.Bytes()
+}
+
+func (g *generator) serviceAccountFile() []byte {
+	f := g.newFile()
+	serviceAccounts := append([]models.ServiceAccount(nil), g.snapshot.ServiceAccounts...)
+	sort.SliceStable(serviceAccounts, func(i, j int) bool { return serviceAccounts[i].Name < serviceAccounts[j].Name })
+
+	for _, serviceAccount := range serviceAccounts {
+		name := g.resourceName("authzed_service_account", serviceAccount.Name)
+		g.serviceAccounts[serviceAccount.ID] = name
+
+		body := g.appendResource(f, "authzed_service_account", name)
+		body.SetAttributeValue("name", cty.StringVal(serviceAccount.Name))
+		setDescription(body, serviceAccount.Description)
+		body.SetAttributeTraversal("permission_system_id", localPermissionsSystemID())
+
+		g.appendImport("authzed_service_account", name, g.snapshot.PermissionsSystemID+":"+serviceAccount.ID)
+	}
+	return f.Bytes()
+}
+
+func (g *generator) policyFile() []byte {
+	f := g.newFile()
+	policies := append([]models.Policy(nil), g.snapshot.Policies...)
+	sort.SliceStable(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+
+	for _, policy := range policies {
+		name := g.resourceName("authzed_policy", policy.Name)
+
+		body := g.appendResource(f, "authzed_policy", name)
+		body.SetAttributeValue("name", cty.StringVal(policy.Name))
+		setDescription(body, policy.Description)
+		body.SetAttributeTraversal("permission_system_id", localPermissionsSystemID())
+		body.SetAttributeRaw("principal_id", g.reference("authzed_service_account", g.serviceAccounts, policy.PrincipalID))
+
+		roleIDs := make([]hclwrite.Tokens, 0, len(policy.RoleIDs))
+		for _, roleID := range policy.RoleIDs {
+			roleIDs = append(roleIDs, g.reference("authzed_role", g.roles, roleID))
+		}
+		body.SetAttributeRaw("role_ids", hclwrite.TokensForTuple(roleIDs))
+
+		g.appendImport("authzed_policy", name, g.snapshot.PermissionsSystemID+":"+policy.ID)
+	}
+	return f.Bytes()
+}
+
+func (g *generator) tokenFile() []byte {
+	f := g.newFile()
+	f.Body().AppendUnstructuredTokens(commentTokens(
+		"Token values are only returned when a token is created, so plain_text is null for imported tokens.",
+	))
+
+	serviceAccounts := append([]models.ServiceAccount(nil), g.snapshot.ServiceAccounts...)
+	sort.SliceStable(serviceAccounts, func(i, j int) bool { return serviceAccounts[i].Name < serviceAccounts[j].Name })
+
+	for _, serviceAccount := range serviceAccounts {
+		tokens := append([]models.TokenRequest(nil), g.snapshot.Tokens[serviceAccount.ID]...)
+		sort.SliceStable(tokens, func(i, j int) bool { return tokens[i].Name < tokens[j].Name })
+
+		for _, token := range tokens {
+			// Token names are only unique per service account
+			name := g.resourceName("authzed_token", g.serviceAccounts[serviceAccount.ID]+"_"+token.Name)
+
+			body := g.appendResource(f, "authzed_token", name)
+			body.SetAttributeValue("name", cty.StringVal(token.Name))
+			setDescription(body, token.Description)
+			body.SetAttributeTraversal("permission_system_id", localPermissionsSystemID())
+			body.SetAttributeRaw("service_account_id", g.reference("authzed_service_account", g.serviceAccounts, serviceAccount.ID))
+
+			g.appendImport("authzed_token", name, g.snapshot.PermissionsSystemID+":"+serviceAccount.ID+":"+token.ID)
+		}
+	}
+	return f.Bytes()
+}
+
+func (g *generator) importFile() []byte {
+	f := g.newFile()
+	f.Body().AppendUnstructuredTokens(g.imports.BuildTokens(nil))
+	return f.Bytes()
+}
+
+// newFile returns a file starting with a comment naming the exported permission system
+func (g *generator) newFile() *hclwrite.File {
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendUnstructuredTokens(commentTokens(
+		fmt.Sprintf("Generated by terraform-provider-authzed export from permission system %s.", g.snapshot.PermissionsSystemID),
+	))
+	return f
+}
+
+// appendResource appends a resource block separated by a blank line
+func (g *generator) appendResource(f *hclwrite.File, resourceType, name string) *hclwrite.Body {
+	f.Body().AppendNewline()
+	return f.Body().AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+// appendImport appends the import block of a resource
+func (g *generator) appendImport(resourceType, name, id string) {
+	g.imports.Body().AppendNewline()
+	body := g.imports.Body().AppendNewBlock("import", nil).Body()
+	body.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	body.SetAttributeValue("id", cty.StringVal(id))
+}
+
+// reference returns the id attribute of the exported resource of an object, or the raw ID if the
+// object was not exported
+func (g *generator) reference(resourceType string, names map[string]string, id string) hclwrite.Tokens {
+	name, ok := names[id]
+	if !ok {
+		return hclwrite.TokensForValue(cty.StringVal(id))
+	}
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+		hcl.TraverseAttr{Name: "id"},
+	})
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName returns a unique resource name derived from an object name
+func (g *generator) resourceName(resourceType, objectName string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(objectName), "_"), "_")
+	if name == "" {
+		name = strings.TrimPrefix(resourceType, "authzed_")
+	}
+	// Names must start with a letter or an underscore
+	if name[0] >= '0' && name[0] <= '9' {
+		name = strings.TrimPrefix(resourceType, "authzed_") + "_" + name
+	}
+
+	unique := name
+	for i := 2; g.names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	g.names[resourceType+"."+unique] = true
+	return unique
+}
+
+func setDescription(body *hclwrite.Body, description string) {
+	if description != "" {
+		body.SetAttributeValue("description", cty.StringVal(description))
+	}
+}
+
+func localPermissionsSystemID() hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: "local"},
+		hcl.TraverseAttr{Name: "permission_system_id"},
+	}
+}
+
+func commentTokens(comment string) hclwrite.Tokens {
+	return hclwrite.Tokens{{
+		Type:  hclsyntax.TokenComment,
+		Bytes: []byte("# " + comment + "\n"),
+	}}
+}
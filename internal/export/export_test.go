@@ -0,0 +1,125 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func testSnapshot() *Snapshot {
+	return &Snapshot{
+		PermissionsSystemID: "ps-123",
+		Roles: []models.Role{
+			{ID: "arl-2", Name: "Writer Role", Permissions: models.PermissionExprMap{"authzed.v1/WriteRelationships": ""}},
+			{ID: "arl-1", Name: "reader", Description: "Read only", Permissions: models.PermissionExprMap{
+				"authzed.v1/ReadSchema":      "",
+				"authzed.v1/CheckPermission": `CheckPermissionRequest.permission == "view"`,
+			}},
+		},
+		ServiceAccounts: []models.ServiceAccount{
+			{ID: "asa-1", Name: "ci"},
+		},
+		Policies: []models.Policy{
+			{ID: "apc-1", Name: "ci-reader", PrincipalID: "asa-1", RoleIDs: []string{"arl-1", "arl-deleted"}},
+		},
+		Tokens: map[string][]models.TokenRequest{
+			"asa-1": {{ID: "atk-1", Name: "deploy"}, {ID: "atk-2", Name: "Deploy"}},
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	files := Generate(testSnapshot())
+
+	for name, content := range files {
+		if _, diags := hclsyntax.ParseConfig(content, name, hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("Generated %s is not valid HCL: %s\n%s", name, diags, content)
+		}
+	}
+
+	testCases := []struct {
+		file string
+		want []string
+	}{
+		{file: "main.tf", want: []string{`permission_system_id = "ps-123"`}},
+		{file: "roles.tf", want: []string{
+			`resource "authzed_role" "reader" {`,
+			`resource "authzed_role" "writer_role" {`,
+			`description          = "Read only"`,
+			`permission_system_id = local.permission_system_id`,
+			`"authzed.v1/CheckPermission" = "CheckPermissionRequest.permission == \"view\""`,
+		}},
+		{file: "policies.tf", want: []string{
+			`principal_id         = authzed_service_account.ci.id`,
+			`role_ids             = [authzed_role.reader.id, "arl-deleted"]`,
+		}},
+		{file: "tokens.tf", want: []string{
+			`resource "authzed_token" "ci_deploy" {`,
+			`resource "authzed_token" "ci_deploy_2" {`,
+			`service_account_id   = authzed_service_account.ci.id`,
+		}},
+		{file: "imports.tf", want: []string{
+			`to = authzed_role.reader`,
+			`id = "ps-123:arl-1"`,
+			`to = authzed_policy.ci_reader`,
+			`id = "ps-123:asa-1:atk-2"`,
+		}},
+	}
+
+	for _, tc := range testCases {
+		content := string(files[tc.file])
+		for _, want := range tc.want {
+			if !strings.Contains(content, want) {
+				t.Errorf("Expected %s to contain %q, got:\n%s", tc.file, want, content)
+			}
+		}
+	}
+}
+
+func TestGenerator_ResourceName(t *testing.T) {
+	g := &generator{names: make(map[string]bool)}
+
+	testCases := []struct {
+		objectName string
+		want       string
+	}{
+		{objectName: "reader", want: "reader"},
+		{objectName: "Reader", want: "reader_2"},
+		{objectName: "prod/ci-bot", want: "prod_ci_bot"},
+		{objectName: "1st", want: "role_1st"},
+		{objectName: "!!!", want: "role"},
+	}
+
+	for _, tc := range testCases {
+		if got := g.resourceName("authzed_role", tc.objectName); got != tc.want {
+			t.Errorf("resourceName(%q) = %q, want %q", tc.objectName, got, tc.want)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "out")
+	files := map[string][]byte{"main.tf": []byte("locals {}\n")}
+
+	paths, err := Write(dir, files)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(paths) != 1 {
+		t.Fatalf("Expected 1 file, got %v", paths)
+	}
+	if content, err := os.ReadFile(paths[0]); err != nil || string(content) != "locals {}\n" {
+		t.Errorf("Unexpected content %q: %v", content, err)
+	}
+
+	// Existing files are never overwritten
+	if _, err := Write(dir, files); err == nil {
+		t.Error("Expected an error when the files already exist")
+	}
+}
@@ -0,0 +1,15 @@
+package models
+
+// SpiceDBChannel is an update channel and the SpiceDB versions available in it
+type SpiceDBChannel struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description,omitempty"`
+	// Versions are sorted from newest to oldest
+	Versions []SpiceDBVersion `json:"versions,omitempty"`
+}
+
+// ListChannelsAndVersionsResponse is the envelope returned by the channels endpoint
+type ListChannelsAndVersionsResponse struct {
+	Channels []SpiceDBChannel `json:"channels"`
+}
@@ -0,0 +1,98 @@
+package models
+
+// DatastoreTypes are the kinds of datastore supported by the API
+var DatastoreTypes = []string{"spanner", "postgres", "cockroachdb"}
+
+// Datastore represents a datastore instance backing one or more permissions systems
+type Datastore struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	ProviderRegion string            `json:"providerRegion,omitempty"`
+	TemplateName   string            `json:"templateName,omitempty"`
+	Type           string            `json:"type"`
+	Compute        *DatastoreCompute `json:"compute,omitempty"`
+}
+
+type DatastoreCompute struct {
+	VirtualCPUs int64 `json:"virtualCPUs"`
+}
+
+// DatastoreResponse is the envelope returned by the datastore endpoints
+type DatastoreResponse struct {
+	Datastore Datastore `json:"datastore"`
+}
+
+// CreateDatastoreRequest provisions a datastore from a template
+type CreateDatastoreRequest struct {
+	Name        string       `json:"name"`
+	Template    string       `json:"template"`
+	CockroachDB *ClusterSpec `json:"cockroachdb,omitempty"`
+}
+
+// UpdateDatastoreRequest updates the name or cluster specification of a datastore
+type UpdateDatastoreRequest struct {
+	Name        string       `json:"name,omitempty"`
+	CockroachDB *ClusterSpec `json:"cockroachdb,omitempty"`
+}
+
+// ClusterSpec describes the cluster backing a datastore
+type ClusterSpec struct {
+	CloudProvider string                     `json:"cloudProvider,omitempty"`
+	ClusterName   string                     `json:"clusterName,omitempty"`
+	Configuration *ClusterConfiguration      `json:"configuration,omitempty"`
+	Labels        map[string]string          `json:"labels,omitempty"`
+	MetricExport  *MetricExportConfiguration `json:"metricExport,omitempty"`
+	ParentID      string                     `json:"parentId,omitempty"`
+	Plan          string                     `json:"plan,omitempty"`
+	RegionName    string                     `json:"regionName,omitempty"`
+	SecretName    string                     `json:"secretName,omitempty"`
+}
+
+type ClusterConfiguration struct {
+	Dedicated        *DedicatedSpecification  `json:"dedicated,omitempty"`
+	DeleteProtection *string                  `json:"deleteProtection,omitempty"`
+	Serverless       *ServerlessSpecification `json:"serverless,omitempty"`
+}
+
+type DedicatedSpecification struct {
+	NumVirtualCPUs int64            `json:"numVirtualCpus,omitempty"`
+	RegionNodes    map[string]int64 `json:"regionNodes,omitempty"`
+	StorageGib     int64            `json:"storageGib,omitempty"`
+}
+
+type ServerlessSpecification struct {
+	PrimaryRegion string       `json:"primaryRegion,omitempty"`
+	Regions       []string     `json:"regions,omitempty"`
+	UpgradeType   string       `json:"upgradeType,omitempty"`
+	UsageLimits   *UsageLimits `json:"usageLimits,omitempty"`
+}
+
+type UsageLimits struct {
+	ProvisionedVirtualCPUs int64 `json:"provisionedVirtualCPUs,omitempty"`
+	RequestUnitLimit       int64 `json:"requestUnitLimit,omitempty"`
+	StorageMibLimit        int64 `json:"storageMibLimit,omitempty"`
+}
+
+type MetricExportConfiguration struct {
+	CloudWatch *CloudWatchMetricExport `json:"cloudWatch,omitempty"`
+	Datadog    *DatadogMetricExport    `json:"datadog,omitempty"`
+	Prometheus *PrometheusMetricExport `json:"prometheus,omitempty"`
+}
+
+type CloudWatchMetricExport struct {
+	Enabled      bool   `json:"enabled"`
+	ExternalID   string `json:"externalId,omitempty"`
+	LogGroupName string `json:"logGroupName,omitempty"`
+	RoleArn      string `json:"roleArn,omitempty"`
+	TargetRegion string `json:"targetRegion,omitempty"`
+}
+
+type DatadogMetricExport struct {
+	APIKey  string `json:"apiKey,omitempty"`
+	Enabled bool   `json:"enabled"`
+	Site    string `json:"site,omitempty"`
+}
+
+type PrometheusMetricExport struct {
+	Enabled bool `json:"enabled"`
+}
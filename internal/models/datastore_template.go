@@ -0,0 +1,24 @@
+package models
+
+// DatastoreTemplate describes a template datastores can be provisioned from
+type DatastoreTemplate struct {
+	Name        string `json:"name"`
+	Provider    string `json:"provider"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Defaults and AllowOverrides are free-form JSON documents
+	Defaults       any                           `json:"defaults"`
+	AllowOverrides any                           `json:"allowOverrides,omitempty"`
+	Validations    []DatastoreTemplateValidation `json:"validations,omitempty"`
+}
+
+// DatastoreTemplateValidation is a CEL expression a datastore configuration must satisfy
+type DatastoreTemplateValidation struct {
+	Expression string `json:"expression"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// ListDatastoreTemplatesResponse is the envelope returned by the datastore templates endpoint
+type ListDatastoreTemplatesResponse struct {
+	Templates []DatastoreTemplate `json:"templates"`
+}
@@ -0,0 +1,26 @@
+package models
+
+// Deployment is a deployment of a permission system in a region
+type Deployment struct {
+	ID                   string               `json:"id"`
+	Name                 string               `json:"name,omitempty"`
+	DeploymentTemplateID string               `json:"deploymentTemplateId"`
+	DNSName              string               `json:"dnsName,omitempty"`
+	RegionID             string               `json:"regionId,omitempty"`
+	Replicas             int64                `json:"replicas,omitempty"`
+	CreatedAt            string               `json:"createdAt,omitempty"`
+	CloudProviderDetails CloudProviderDetails `json:"cloudProviderDetails"`
+	SystemState          DeploymentState      `json:"systemState"`
+}
+
+// CloudProviderDetails are the cloud identities associated with a deployment
+type CloudProviderDetails struct {
+	AWSAccountID      string `json:"awsAccountID,omitempty"`
+	GCPServiceAccount string `json:"gcpServiceAccount,omitempty"`
+}
+
+// DeploymentState is the status of a deployment
+type DeploymentState struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
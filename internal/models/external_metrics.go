@@ -0,0 +1,26 @@
+package models
+
+// ExternalMetricsToken is a token used to scrape permission system metrics into Prometheus
+type ExternalMetricsToken struct {
+	ID                  string `json:"id"`
+	PermissionsSystemID string `json:"permissionsSystemID,omitempty"`
+	// Secret is only returned when the token is created or rotated
+	Secret string `json:"secret,omitempty"`
+}
+
+// ExternalMetricsTokenListItem is a token as returned by the list endpoint
+type ExternalMetricsTokenListItem struct {
+	ID string `json:"id"`
+	// Hash is the SHA256 hash of the secret part of the token, empty if the token no longer exists
+	Hash string `json:"hash"`
+}
+
+// ListExternalMetricsTokensResponse is the envelope returned by the list endpoint
+type ListExternalMetricsTokensResponse struct {
+	Tokens []ExternalMetricsTokenListItem `json:"tokens"`
+}
+
+// RotateExternalMetricsTokenResponse contains the new secret of a rotated token
+type RotateExternalMetricsTokenResponse struct {
+	Secret string `json:"secret"`
+}
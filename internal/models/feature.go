@@ -0,0 +1,47 @@
+package models
+
+// AuditLogTargetTypes are the destination sinks supported by the audit log feature
+var AuditLogTargetTypes = []string{"aws-kinesis", "aws-firehose", "aws-s3", "gcp-cloud-storage"}
+
+// AuditLogFeatureConfig configures where audit log events are delivered
+type AuditLogFeatureConfig struct {
+	DisabledOnMethods   []string          `json:"disabledOnMethods,omitempty"`
+	StreamName          string            `json:"streamName"`
+	TargetConfiguration map[string]string `json:"targetConfiguration,omitempty"`
+	TargetEndpointURL   string            `json:"targetEndpointUrl,omitempty"`
+	TargetType          string            `json:"targetType"`
+}
+
+// AuditLogFeature is the audit log feature of a permission system
+type AuditLogFeature struct {
+	Config      *AuditLogFeatureConfig `json:"config,omitempty"`
+	DisplayName string                 `json:"displayName,omitempty"`
+	Enabled     bool                   `json:"enabled"`
+	ID          string                 `json:"id,omitempty"`
+}
+
+// SetAuditLogFeatureRequest enables or disables the audit log feature
+type SetAuditLogFeatureRequest struct {
+	Config  *AuditLogFeatureConfig `json:"config,omitempty"`
+	Enabled bool                   `json:"enabled"`
+}
+
+// DatadogExportFeatureConfig is the readable configuration of the Datadog export feature
+type DatadogExportFeatureConfig struct {
+	Site string `json:"site"`
+}
+
+// DatadogExportFeature is the Datadog metric export feature of a permission system
+type DatadogExportFeature struct {
+	Config      *DatadogExportFeatureConfig `json:"config,omitempty"`
+	DisplayName string                      `json:"displayName,omitempty"`
+	Enabled     bool                        `json:"enabled"`
+	ID          string                      `json:"id,omitempty"`
+}
+
+// SetDatadogExportFeatureRequest configures and enables the Datadog export feature.
+// The API key is never returned by the API after it has been written.
+type SetDatadogExportFeatureRequest struct {
+	APIKey string `json:"apiKey"`
+	Site   string `json:"site"`
+}
@@ -0,0 +1,42 @@
+package models
+
+// MaterializeCluster is a Materialize cluster that consumes updates from a permission system
+type MaterializeCluster struct {
+	ID                  string                   `json:"id"`
+	Name                string                   `json:"name"`
+	PermissionsSystemID string                   `json:"permissionsSystemID"`
+	DeploymentID        string                   `json:"deploymentID"`
+	WatchedPermissions  []string                 `json:"watchedPermissions"`
+	Status              MaterializeClusterStatus `json:"status"`
+}
+
+// MaterializeClusterStatus is the observed status of a Materialize cluster
+type MaterializeClusterStatus struct {
+	Conditions       []MaterializeClusterStatusCondition `json:"conditions"`
+	DatabaseName     string                              `json:"databaseName"`
+	Region           string                              `json:"region"`
+	LatestSnapshot   *MaterializeClusterJobStatus        `json:"latestSnapshot,omitempty"`
+	LatestGroupStore *MaterializeClusterJobStatus        `json:"latestGroupStore,omitempty"`
+}
+
+// MaterializeClusterStatusCondition is a Kubernetes-style status condition
+type MaterializeClusterStatusCondition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Reason             string `json:"reason"`
+	Message            string `json:"message"`
+	ObservedGeneration int64  `json:"observedGeneration"`
+	LastTransitionTime string `json:"lastTransitionTime"`
+}
+
+// MaterializeClusterJobStatus is the status of a snapshot or group store job
+type MaterializeClusterJobStatus struct {
+	Tag            string `json:"tag"`
+	StartTime      string `json:"startTime"`
+	CompletionTime string `json:"completionTime,omitempty"`
+}
+
+// ListMaterializeClustersResponse is the envelope returned by the materialize endpoint
+type ListMaterializeClustersResponse struct {
+	Clusters []MaterializeCluster `json:"clusters"`
+}
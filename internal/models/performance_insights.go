@@ -0,0 +1,26 @@
+package models
+
+// PerformanceInsightsPeriods are the time periods performance insights can be analyzed over
+var PerformanceInsightsPeriods = []string{"1m", "5m", "10m", "30m", "1h", "6h", "12h", "24h"}
+
+// PerformanceInsightsSortBy are the fields the top K API shapes can be sorted by
+var PerformanceInsightsSortBy = []string{"impact", "count", "p50", "p95", "p99"}
+
+// APIShapeMetrics describes the latency of a shape of permissions API call over a period
+type APIShapeMetrics struct {
+	ShapeID            string            `json:"shapeID"`
+	APIName            string            `json:"apiName"`
+	APICategory        string            `json:"apiCategory"`
+	APIIndex           int64             `json:"apiIndex"`
+	Count              *int64            `json:"count,omitempty"`
+	ShapeFields        map[string]string `json:"shapeFields,omitempty"`
+	OrderedShapeFields []string          `json:"orderedShapeFields,omitempty"`
+	P50LatencySeconds  *float64          `json:"p50LatencySeconds,omitempty"`
+	P95LatencySeconds  *float64          `json:"p95LatencySeconds,omitempty"`
+	P99LatencySeconds  *float64          `json:"p99LatencySeconds,omitempty"`
+}
+
+// PerfInsightsTopKResponse is the response of the performance insights top K endpoint
+type PerfInsightsTopKResponse struct {
+	Shapes []APIShapeMetrics `json:"shapes"`
+}
@@ -1,12 +1,31 @@
 package models
 
 type PermissionsSystem struct {
-	ID            string                 `json:"id"`
-	Name          string                 `json:"name"`
-	GlobalDnsPath string                 `json:"globalDnsPath"`
-	SystemType    string                 `json:"systemType"`
-	SystemState   PermissionsSystemState `json:"systemState"`
-	Version       SystemVersion          `json:"version"`
+	ID                string                      `json:"id"`
+	Name              string                      `json:"name"`
+	GlobalDnsPath     string                      `json:"globalDnsPath"`
+	SystemType        string                      `json:"systemType"`
+	SystemState       PermissionsSystemState      `json:"systemState"`
+	Version           SystemVersion               `json:"version"`
+	Capabilities      []string                    `json:"capabilities,omitempty"`
+	Features          []PermissionsSystemFeature  `json:"features,omitempty"`
+	Datastore         *PermissionsSystemDatastore `json:"datastore,omitempty"`
+	AvailableVersions []SpiceDBVersion            `json:"availableVersions,omitempty"`
+}
+
+// PermissionsSystemFeature is a feature of a permissions system and whether it is enabled
+type PermissionsSystemFeature struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// PermissionsSystemDatastore is the datastore backing a permissions system
+type PermissionsSystemDatastore struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Region     string `json:"region,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
 }
 
 type PermissionsSystemState struct {
@@ -15,15 +34,25 @@ type PermissionsSystemState struct {
 }
 
 type SystemVersion struct {
-	CurrentVersion     SpiceDBVersion `json:"currentVersion"`
-	HasUpdateAvailable bool           `json:"hasUpdateAvailable"`
-	IsLockedToVersion  bool           `json:"isLockedToVersion"`
-	OverrideImage      string         `json:"overrideImage"`
-	SelectedChannel    string         `json:"selectedChannel"`
+	CurrentVersion             SpiceDBVersion `json:"currentVersion"`
+	HasUpdateAvailable         bool           `json:"hasUpdateAvailable"`
+	IsLockedToVersion          bool           `json:"isLockedToVersion"`
+	OverrideImage              string         `json:"overrideImage"`
+	SelectedChannel            string         `json:"selectedChannel"`
+	SelectedChannelDisplayName string         `json:"selectedChannelDisplayName,omitempty"`
 }
 
 type SpiceDBVersion struct {
 	DisplayName           string   `json:"displayName"`
+	SupportedAPIs         []string `json:"supportedAPIs,omitempty"`
 	SupportedFeatureNames []string `json:"supportedFeatureNames"`
 	Version               string   `json:"version"`
 }
+
+// UpdatePermissionsSystemRequest represents the mutable settings of a permissions system
+type UpdatePermissionsSystemRequest struct {
+	Name            string  `json:"name,omitempty"`
+	SystemType      string  `json:"systemType,omitempty"`
+	Channel         string  `json:"channel,omitempty"`
+	SelectedVersion *string `json:"selectedVersion,omitempty"`
+}
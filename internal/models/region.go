@@ -0,0 +1,13 @@
+package models
+
+// CloudKinds are the cloud providers a region can belong to
+var CloudKinds = []string{"AWS", "AZURE", "GCLOUD", "NONE", "UNKNOWN"}
+
+// Region is a region available for SpiceDB clusters
+type Region struct {
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	CloudKind      string `json:"cloudKind"`
+	CloudAccountID string `json:"cloudAccountId"`
+	DNSSuffix      string `json:"dnsSuffix"`
+}
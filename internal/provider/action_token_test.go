@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testTokenActionServer serves the tokens of service account asa-1 and records the requests it receives
+type testTokenActionServer struct {
+	mu       sync.Mutex
+	requests []string
+	// failDelete is the ID of a token whose deletion fails
+	failDelete string
+}
+
+func (s *testTokenActionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-1/tokens":
+		_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+			{"id": "atk-1", "name": "deploy"},
+			{"id": "atk-2", "name": "ci"},
+		}})
+	case r.Method == http.MethodGet && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-1/tokens/atk-1":
+		w.Header().Set("ETag", "etag-1")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id": "atk-1", "name": "deploy", "description": "Deploy token",
+			"permissionsSystemID": "ps-123", "serviceAccountID": "asa-1",
+		})
+	case r.Method == http.MethodPost && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-1/tokens":
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("ETag", "etag-2")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id": "atk-3", "name": body["name"], "description": body["description"],
+			"permissionsSystemID": "ps-123", "serviceAccountID": "asa-1", "secret": "sdbst_secret",
+		})
+	case r.Method == http.MethodDelete && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-1/tokens/"+s.failDelete:
+		w.WriteHeader(http.StatusInternalServerError)
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *testTokenActionServer) calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// testInvokeAction invokes an action and returns its progress messages and final diagnostics
+func testInvokeAction(t *testing.T, endpoint, actionType string, config map[string]tftypes.Value) ([]string, []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	ctx := context.Background()
+	providerServer, schemaResp := testConfiguredProviderServer(t, endpoint)
+	actionSchema := schemaResp.ActionSchemas[actionType]
+	if actionSchema == nil {
+		t.Fatalf("Expected the %s action to be registered", actionType)
+	}
+
+	actionServer, ok := providerServer.(tfprotov6.ActionServer)
+	if !ok {
+		t.Fatal("Expected the provider server to support actions")
+	}
+
+	stream, err := actionServer.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: actionType,
+		Config:     testDynamicValue(t, actionSchema.Schema, config),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var progress []string
+	var diagnostics []*tfprotov6.Diagnostic
+	for event := range stream.Events {
+		switch e := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			progress = append(progress, e.Message)
+		case tfprotov6.CompletedInvokeActionEventType:
+			diagnostics = e.Diagnostics
+		}
+	}
+	return progress, diagnostics
+}
+
+func TestRotateTokenAction(t *testing.T) {
+	handler := &testTokenActionServer{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	progress, diagnostics := testInvokeAction(t, server.URL, "authzed_rotate_token", map[string]tftypes.Value{
+		"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123"),
+		"service_account_id":   tftypes.NewValue(tftypes.String, "asa-1"),
+		"token_id":             tftypes.NewValue(tftypes.String, "atk-1"),
+	})
+	if len(diagnostics) > 0 {
+		for _, d := range diagnostics {
+			t.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+		t.FailNow()
+	}
+
+	want := []string{
+		"GET /ps/ps-123/access/service-accounts/asa-1/tokens/atk-1",
+		"POST /ps/ps-123/access/service-accounts/asa-1/tokens",
+		"DELETE /ps/ps-123/access/service-accounts/asa-1/tokens/atk-1",
+	}
+	calls := handler.calls()
+	if len(calls) != len(want) {
+		t.Fatalf("Expected requests %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Expected requests %v, got %v", want, calls)
+		}
+	}
+
+	if len(progress) != 2 || progress[0] != "Created replacement token atk-3 (deploy)" {
+		t.Errorf("Unexpected progress: %v", progress)
+	}
+}
+
+func TestRevokeServiceAccountTokensAction(t *testing.T) {
+	handler := &testTokenActionServer{failDelete: "atk-1"}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	progress, diagnostics := testInvokeAction(t, server.URL, "authzed_revoke_service_account_tokens", map[string]tftypes.Value{
+		"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123"),
+		"service_account_id":   tftypes.NewValue(tftypes.String, "asa-1"),
+	})
+
+	// A failed deletion is reported, and the remaining tokens are still revoked
+	if len(diagnostics) != 1 || diagnostics[0].Severity != tfprotov6.DiagnosticSeverityError {
+		t.Fatalf("Expected 1 error, got %v", diagnostics)
+	}
+
+	deleted := 0
+	for _, call := range handler.calls() {
+		if call == "DELETE /ps/ps-123/access/service-accounts/asa-1/tokens/atk-2" {
+			deleted++
+		}
+	}
+	if deleted != 1 {
+		t.Errorf("Expected atk-2 to be deleted, got %v", handler.calls())
+	}
+
+	if len(progress) == 0 || progress[len(progress)-1] != "Revoked 1 of 2 tokens of service account asa-1" {
+		t.Errorf("Unexpected progress: %v", progress)
+	}
+}
@@ -0,0 +1,156 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/semaphore"
+)
+
+// Kind is a type of access-management object whose mutating operations are limited
+type Kind string
+
+const (
+	ServiceAccounts Kind = "service_accounts"
+	Tokens          Kind = "tokens"
+	Policies        Kind = "policies"
+	Roles           Kind = "roles"
+)
+
+// Default limits, as documented on the provider's max_concurrent_* settings
+const (
+	DefaultMaxConcurrentServiceAccounts = 6
+	DefaultMaxConcurrentTokens          = 8
+	DefaultMaxConcurrentPolicies        = 3
+	DefaultMaxConcurrentRoles           = 3
+)
+
+// Thresholds above which auto parallelism serializes operations, matching the documented
+// recommendations for running with -parallelism=1
+const (
+	autoMaxMixedOperations          = 8
+	autoMaxServiceAccountOperations = 5
+	autoMaxOperations               = 50
+)
+
+// Config holds the maximum number of concurrent mutating operations per kind
+type Config struct {
+	MaxConcurrent   map[Kind]int64
+	AutoParallelism bool
+}
+
+// DefaultConfig returns the default limits with auto parallelism disabled
+func DefaultConfig() Config {
+	return Config{
+		MaxConcurrent: map[Kind]int64{
+			ServiceAccounts: DefaultMaxConcurrentServiceAccounts,
+			Tokens:          DefaultMaxConcurrentTokens,
+			Policies:        DefaultMaxConcurrentPolicies,
+			Roles:           DefaultMaxConcurrentRoles,
+		},
+	}
+}
+
+// Limits bounds the number of concurrent mutating operations per kind with weighted semaphores.
+// With auto parallelism, it also serializes all operations once the number of operations of a run
+// exceeds the thresholds at which -parallelism=1 is recommended.
+type Limits struct {
+	semaphores map[Kind]*semaphore.Weighted
+	auto       bool
+	// serial serializes all operations once auto parallelism kicks in
+	serial *semaphore.Weighted
+
+	mutex      sync.Mutex
+	operations map[Kind]int
+	total      int
+	serialized bool
+}
+
+// NewLimits creates limits from the given configuration. Kinds without a positive limit use their default.
+func NewLimits(config Config) *Limits {
+	defaults := DefaultConfig()
+	semaphores := make(map[Kind]*semaphore.Weighted, len(defaults.MaxConcurrent))
+	for kind, defaultLimit := range defaults.MaxConcurrent {
+		limit := config.MaxConcurrent[kind]
+		if limit <= 0 {
+			limit = defaultLimit
+		}
+		semaphores[kind] = semaphore.NewWeighted(limit)
+	}
+
+	return &Limits{
+		semaphores: semaphores,
+		auto:       config.AutoParallelism,
+		serial:     semaphore.NewWeighted(1),
+		operations: make(map[Kind]int),
+	}
+}
+
+// With executes fn once a slot for the given kind is available. A nil Limits does not limit anything.
+func (l *Limits) With(ctx context.Context, kind Kind, fn func() error) error {
+	if l == nil {
+		return fn()
+	}
+
+	sem, ok := l.semaphores[kind]
+	if !ok {
+		return fn()
+	}
+	if err := sem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer sem.Release(1)
+
+	if l.record(ctx, kind) {
+		if err := l.serial.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		defer l.serial.Release(1)
+	}
+
+	return fn()
+}
+
+// record counts an operation of the given kind and reports whether operations must be serialized
+func (l *Limits) record(ctx context.Context, kind Kind) bool {
+	if !l.auto {
+		return false
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.operations[kind]++
+	l.total++
+	if l.serialized {
+		return true
+	}
+
+	mixed := false
+	for other, count := range l.operations {
+		if other != kind && count > 0 {
+			mixed = true
+			break
+		}
+	}
+
+	var reason string
+	switch {
+	case l.operations[ServiceAccounts] > autoMaxServiceAccountOperations:
+		reason = "more than 5 service account operations"
+	case mixed && l.total > autoMaxMixedOperations:
+		reason = "more than 8 operations on mixed resource types"
+	case l.total > autoMaxOperations:
+		reason = "more than 50 operations"
+	default:
+		return false
+	}
+
+	l.serialized = true
+	tflog.Warn(ctx, "Serializing access-management operations; run terraform with -parallelism=1 to avoid FGAM conflicts in large deployments", map[string]any{
+		"reason":     reason,
+		"operations": l.total,
+	})
+	return true
+}
@@ -0,0 +1,90 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// maxInFlight runs n operations of the given kinds concurrently and returns the highest number
+// of operations that ran at the same time
+func maxInFlight(t *testing.T, limits *Limits, kinds []Kind) int32 {
+	t.Helper()
+
+	var (
+		wg       sync.WaitGroup
+		inFlight atomic.Int32
+		peak     atomic.Int32
+	)
+	for _, kind := range kinds {
+		wg.Add(1)
+		go func(kind Kind) {
+			defer wg.Done()
+			err := limits.With(context.Background(), kind, func() error {
+				current := inFlight.Add(1)
+				for {
+					previous := peak.Load()
+					if current <= previous || peak.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				inFlight.Add(-1)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}(kind)
+	}
+	wg.Wait()
+	return peak.Load()
+}
+
+func repeat(kind Kind, n int) []Kind {
+	kinds := make([]Kind, n)
+	for i := range kinds {
+		kinds[i] = kind
+	}
+	return kinds
+}
+
+func TestLimits_MaxConcurrent(t *testing.T) {
+	limits := NewLimits(Config{MaxConcurrent: map[Kind]int64{Roles: 2}})
+
+	if peak := maxInFlight(t, limits, repeat(Roles, 10)); peak > 2 {
+		t.Errorf("Expected at most 2 concurrent role operations, got %d", peak)
+	}
+	if peak := maxInFlight(t, limits, repeat(Tokens, 20)); peak > DefaultMaxConcurrentTokens {
+		t.Errorf("Expected at most %d concurrent token operations, got %d", DefaultMaxConcurrentTokens, peak)
+	}
+}
+
+func TestLimits_AutoParallelism(t *testing.T) {
+	limits := NewLimits(Config{AutoParallelism: true})
+
+	// A handful of operations run concurrently
+	if peak := maxInFlight(t, limits, repeat(Tokens, 4)); peak < 2 {
+		t.Errorf("Expected token operations to run concurrently, got %d", peak)
+	}
+
+	// Mixed operations beyond the threshold are serialized
+	kinds := append(repeat(Roles, 3), repeat(Policies, 3)...)
+	maxInFlight(t, limits, kinds)
+	if peak := maxInFlight(t, limits, repeat(Tokens, 8)); peak != 1 {
+		t.Errorf("Expected operations to be serialized, got %d concurrent operations", peak)
+	}
+}
+
+func TestLimits_Nil(t *testing.T) {
+	var limits *Limits
+	called := false
+	if err := limits.With(context.Background(), Roles, func() error {
+		called = true
+		return nil
+	}); err != nil || !called {
+		t.Errorf("Expected nil limits to run the operation, got called=%v err=%v", called, err)
+	}
+}
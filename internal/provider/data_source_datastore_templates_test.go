@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestDatastoreTemplatesSchema(t *testing.T) {
+	resp := &datasource.SchemaResponse{}
+	NewDatastoreTemplatesDataSource().Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+		t.Fatalf("Invalid schema implementation: %v", diags)
+	}
+}
+
+func TestSetDatastoreTemplatesData(t *testing.T) {
+	ctx := context.Background()
+
+	var templates []models.DatastoreTemplate
+	err := json.Unmarshal([]byte(`[{
+		"name": "crdb-dedicated",
+		"provider": "cockroachdb",
+		"defaults": {"numVirtualCpus": 4, "regions": ["us-east-1", "us-west-2"], "deleteProtection": null},
+		"allowOverrides": ["numVirtualCpus"],
+		"validations": [{"expression": "self.numVirtualCpus >= 4", "reason": "at least 4 vCPUs"}]
+	}]`), &templates)
+	if err != nil {
+		t.Fatalf("Failed to decode templates: %v", err)
+	}
+
+	var data datastoreTemplatesDataSourceModel
+	if diags := setDatastoreTemplatesData(ctx, &data, templates); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if len(data.Templates) != 1 || len(data.Templates[0].Validations) != 1 {
+		t.Fatalf("Unexpected templates: %+v", data.Templates)
+	}
+	if data.Templates[0].Validations[0].Reason.ValueString() != "at least 4 vCPUs" {
+		t.Errorf("Unexpected validation: %+v", data.Templates[0].Validations[0])
+	}
+
+	defaults, ok := data.Defaults.UnderlyingValue().(types.Object)
+	if !ok {
+		t.Fatalf("Expected defaults to be an object, got %T", data.Defaults.UnderlyingValue())
+	}
+	crdb, ok := defaults.Attributes()["crdb-dedicated"].(types.Object)
+	if !ok {
+		t.Fatalf("Expected defaults for crdb-dedicated, got %v", defaults)
+	}
+	cpus, ok := crdb.Attributes()["numVirtualCpus"].(types.Number)
+	if !ok || cpus.ValueBigFloat().Cmp(big.NewFloat(4)) != 0 {
+		t.Errorf("Expected numVirtualCpus = 4, got %v", crdb.Attributes()["numVirtualCpus"])
+	}
+	if regions, ok := crdb.Attributes()["regions"].(types.Tuple); !ok || len(regions.Elements()) != 2 {
+		t.Errorf("Expected regions tuple, got %v", crdb.Attributes()["regions"])
+	}
+	if !crdb.Attributes()["deleteProtection"].IsNull() {
+		t.Errorf("Expected deleteProtection to be null, got %v", crdb.Attributes()["deleteProtection"])
+	}
+
+	overrides, ok := data.AllowOverrides.UnderlyingValue().(types.Object)
+	if !ok || overrides.Attributes()["crdb-dedicated"] == nil {
+		t.Errorf("Unexpected allow_overrides: %v", data.AllowOverrides)
+	}
+}
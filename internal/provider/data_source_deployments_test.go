@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestDeploymentsNotRunning(t *testing.T) {
+	deployments := []models.Deployment{
+		{ID: "dp-running", Name: "us-east", SystemState: models.DeploymentState{Status: "RUNNING"}},
+		{ID: "dp-failed", Name: "eu-west", SystemState: models.DeploymentState{Status: "VALIDATION_FAILED", Message: "invalid schema"}},
+		{ID: "dp-migrating", Name: "ap-south", SystemState: models.DeploymentState{Status: "MIGRATING"}},
+	}
+
+	notRunning := deploymentsNotRunning(deployments)
+	if len(notRunning) != 2 {
+		t.Fatalf("Expected 2 deployments not running, got %v", notRunning)
+	}
+	if !strings.Contains(notRunning[0], "dp-failed") || !strings.Contains(notRunning[0], "invalid schema") {
+		t.Errorf("Expected the failed deployment and its message, got %q", notRunning[0])
+	}
+	if !strings.Contains(notRunning[1], "MIGRATING") {
+		t.Errorf("Expected the migrating deployment, got %q", notRunning[1])
+	}
+
+	if got := deploymentsNotRunning(deployments[:1]); len(got) != 0 {
+		t.Errorf("Expected all deployments running, got %v", got)
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestMaterializeClusterReady(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conditions []models.MaterializeClusterStatusCondition
+		want       bool
+	}{
+		{name: "NoConditions", want: false},
+		{
+			name: "ReadyTrue",
+			conditions: []models.MaterializeClusterStatusCondition{
+				{Type: "Progressing", Status: "False"},
+				{Type: "Ready", Status: "True"},
+			},
+			want: true,
+		},
+		{
+			name:       "ReadyFalse",
+			conditions: []models.MaterializeClusterStatusCondition{{Type: "Ready", Status: "False", Reason: "SnapshotPending"}},
+			want:       false,
+		},
+		{
+			name:       "OtherConditionsOnly",
+			conditions: []models.MaterializeClusterStatusCondition{{Type: "Available", Status: "True"}},
+			want:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := materializeClusterReady(tc.conditions); got != tc.want {
+				t.Errorf("Expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewMaterializeClusterModel(t *testing.T) {
+	cluster := models.MaterializeCluster{
+		ID:                 "mc-test123",
+		Name:               "orders",
+		DeploymentID:       "dp-test123",
+		WatchedPermissions: []string{"document#view"},
+		Status: models.MaterializeClusterStatus{
+			DatabaseName:   "orders_db",
+			Region:         "us-east-1",
+			Conditions:     []models.MaterializeClusterStatusCondition{{Type: "Ready", Status: "True"}},
+			LatestSnapshot: &models.MaterializeClusterJobStatus{Tag: "snap-1", StartTime: "2025-01-01T00:00:00Z"},
+		},
+	}
+
+	model, diags := newMaterializeClusterModel(context.Background(), cluster)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if model.DatabaseName.ValueString() != "orders_db" || model.Region.ValueString() != "us-east-1" || !model.Ready.ValueBool() {
+		t.Errorf("Unexpected model: %+v", model)
+	}
+	if model.LatestSnapshot == nil || model.LatestSnapshot.Tag.ValueString() != "snap-1" {
+		t.Errorf("Expected the latest snapshot, got %+v", model.LatestSnapshot)
+	}
+	if model.LatestGroupStore != nil {
+		t.Errorf("Expected no group store status, got %+v", model.LatestGroupStore)
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestNewAPIShapeMetricsModel(t *testing.T) {
+	count := int64(1200)
+	p99 := 0.031
+	shape := models.APIShapeMetrics{
+		ShapeID:     "shape-1",
+		APIName:     "CheckPermission",
+		APICategory: "check",
+		Count:       &count,
+		ShapeFields: map[string]string{"resource_type": "document"},
+		// P50 and P95 are missing from the response
+		P99LatencySeconds: &p99,
+	}
+
+	model, diags := newAPIShapeMetricsModel(context.Background(), shape)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if model.Count.ValueInt64() != 1200 || model.P99LatencySeconds.ValueFloat64() != 0.031 {
+		t.Errorf("Unexpected metrics: %+v", model)
+	}
+	if !model.P50LatencySeconds.IsNull() || !model.P95LatencySeconds.IsNull() {
+		t.Errorf("Expected missing latencies to be null, got %+v", model)
+	}
+	if len(model.ShapeFields.Elements()) != 1 || model.OrderedShapeFields.IsNull() {
+		t.Errorf("Unexpected shape fields: %v, %v", model.ShapeFields, model.OrderedShapeFields)
+	}
+
+	empty, diags := newAPIShapeMetricsModel(context.Background(), models.APIShapeMetrics{ShapeID: "shape-2"})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if !empty.Count.IsNull() || empty.ShapeFields.IsNull() {
+		t.Errorf("Expected null count and empty shape fields, got %+v", empty)
+	}
+}
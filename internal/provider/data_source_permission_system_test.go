@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestNewPermissionsSystemDetails(t *testing.T) {
+	ctx := context.Background()
+	permissionsSystem := &models.PermissionsSystem{
+		ID: "ps-test123",
+		Version: models.SystemVersion{
+			CurrentVersion: models.SpiceDBVersion{
+				Version:       "v1.41.0",
+				SupportedAPIs: []string{"authzed.v1/CheckPermission"},
+			},
+			SelectedChannel:            "stable",
+			SelectedChannelDisplayName: "Stable",
+		},
+		AvailableVersions: []models.SpiceDBVersion{{Version: "v1.42.0"}},
+		Capabilities:      []string{"role_management", "version_pinning"},
+		Features:          []models.PermissionsSystemFeature{{ID: "AuditLog", DisplayName: "Audit Log", Enabled: true}},
+		Datastore:         &models.PermissionsSystemDatastore{ID: "dbi-test123", Type: "cockroachdb", Region: "us-east-1"},
+	}
+
+	details, diags := newPermissionsSystemDetails(ctx, permissionsSystem)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	// The values must match the schema types, or setting state fails
+	schemaResp := &datasource.SchemaResponse{}
+	NewPermissionsSystemDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	checks := map[string]types.Object{"version": details.Version, "datastore": details.Datastore}
+	for name, value := range checks {
+		if !schemaResp.Schema.Attributes[name].GetType().Equal(value.Type(ctx)) {
+			t.Errorf("Type of %s does not match the schema: %s", name, value.Type(ctx))
+		}
+	}
+	if !schemaResp.Schema.Attributes["features"].GetType().Equal(details.Features.Type(ctx)) {
+		t.Errorf("Type of features does not match the schema: %s", details.Features.Type(ctx))
+	}
+
+	var capabilities []string
+	diags = details.Capabilities.ElementsAs(ctx, &capabilities, false)
+	if diags.HasError() || len(capabilities) != 2 || capabilities[0] != "role_management" {
+		t.Errorf("Unexpected capabilities: %v", capabilities)
+	}
+	if details.Datastore.IsNull() || details.Datastore.Attributes()["type"].(types.String).ValueString() != "cockroachdb" {
+		t.Errorf("Unexpected datastore: %v", details.Datastore)
+	}
+	if details.Version.Attributes()["selected_channel_display_name"].(types.String).ValueString() != "Stable" {
+		t.Errorf("Unexpected version: %v", details.Version)
+	}
+
+	// Systems without a datastore get a null datastore and empty lists
+	details, diags = newPermissionsSystemDetails(ctx, &models.PermissionsSystem{ID: "ps-test456"})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if !details.Datastore.IsNull() || details.Capabilities.IsNull() || details.Features.IsNull() {
+		t.Errorf("Expected null datastore and empty lists, got %+v", details)
+	}
+}
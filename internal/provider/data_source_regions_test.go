@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestFilterRegions(t *testing.T) {
+	regions := []models.Region{
+		{ID: "us-east-1", CloudKind: "AWS", CloudAccountID: "123456789012", DNSSuffix: "aws.authzed.net"},
+		{ID: "us-central1", CloudKind: "GCLOUD", CloudAccountID: "authzed-prod", DNSSuffix: "gcp.authzed.net"},
+	}
+
+	if got := filterRegions(regions, ""); len(got) != 2 {
+		t.Errorf("Expected all regions without a filter, got %d", len(got))
+	}
+
+	got := filterRegions(regions, "GCLOUD")
+	if len(got) != 1 || got[0].ID.ValueString() != "us-central1" || got[0].CloudAccountID.ValueString() != "authzed-prod" {
+		t.Errorf("Unexpected filtered regions: %+v", got)
+	}
+
+	if got := filterRegions(regions, "AZURE"); len(got) != 0 {
+		t.Errorf("Expected no AZURE regions, got %+v", got)
+	}
+}
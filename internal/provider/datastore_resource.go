@@ -0,0 +1,647 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var (
+	_ resource.Resource                = &datastoreResource{}
+	_ resource.ResourceWithImportState = &datastoreResource{}
+	_ resource.ResourceWithModifyPlan  = &datastoreResource{}
+)
+
+func NewDatastoreResource() resource.Resource {
+	return &datastoreResource{}
+}
+
+type datastoreResource struct {
+	client *client.CloudClient
+}
+
+type datastoreResourceModel struct {
+	ID             types.String               `tfsdk:"id"`
+	Name           types.String               `tfsdk:"name"`
+	Template       types.String               `tfsdk:"template"`
+	Type           types.String               `tfsdk:"type"`
+	ProviderRegion types.String               `tfsdk:"provider_region"`
+	VirtualCPUs    types.Int64                `tfsdk:"virtual_cpus"`
+	CockroachDB    *datastoreClusterSpecModel `tfsdk:"cockroachdb"`
+}
+
+type datastoreClusterSpecModel struct {
+	CloudProvider types.String                        `tfsdk:"cloud_provider"`
+	ClusterName   types.String                        `tfsdk:"cluster_name"`
+	Plan          types.String                        `tfsdk:"plan"`
+	RegionName    types.String                        `tfsdk:"region_name"`
+	ParentID      types.String                        `tfsdk:"parent_id"`
+	SecretName    types.String                        `tfsdk:"secret_name"`
+	Labels        types.Map                           `tfsdk:"labels"`
+	Configuration *datastoreClusterConfigurationModel `tfsdk:"configuration"`
+	MetricExport  *datastoreMetricExportModel         `tfsdk:"metric_export"`
+}
+
+type datastoreClusterConfigurationModel struct {
+	DeleteProtection types.String              `tfsdk:"delete_protection"`
+	Dedicated        *datastoreDedicatedModel  `tfsdk:"dedicated"`
+	Serverless       *datastoreServerlessModel `tfsdk:"serverless"`
+}
+
+type datastoreDedicatedModel struct {
+	NumVirtualCPUs types.Int64 `tfsdk:"num_virtual_cpus"`
+	RegionNodes    types.Map   `tfsdk:"region_nodes"`
+	StorageGib     types.Int64 `tfsdk:"storage_gib"`
+}
+
+type datastoreServerlessModel struct {
+	PrimaryRegion types.String               `tfsdk:"primary_region"`
+	Regions       types.List                 `tfsdk:"regions"`
+	UpgradeType   types.String               `tfsdk:"upgrade_type"`
+	UsageLimits   *datastoreUsageLimitsModel `tfsdk:"usage_limits"`
+}
+
+type datastoreUsageLimitsModel struct {
+	ProvisionedVirtualCPUs types.Int64 `tfsdk:"provisioned_virtual_cpus"`
+	RequestUnitLimit       types.Int64 `tfsdk:"request_unit_limit"`
+	StorageMibLimit        types.Int64 `tfsdk:"storage_mib_limit"`
+}
+
+type datastoreMetricExportModel struct {
+	CloudWatch *datastoreCloudWatchModel `tfsdk:"cloudwatch"`
+	Datadog    *datastoreDatadogModel    `tfsdk:"datadog"`
+	Prometheus *datastorePrometheusModel `tfsdk:"prometheus"`
+}
+
+type datastoreCloudWatchModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	ExternalID   types.String `tfsdk:"external_id"`
+	LogGroupName types.String `tfsdk:"log_group_name"`
+	RoleArn      types.String `tfsdk:"role_arn"`
+	TargetRegion types.String `tfsdk:"target_region"`
+}
+
+type datastoreDatadogModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	APIKey        types.String `tfsdk:"api_key"`
+	APIKeyVersion types.String `tfsdk:"api_key_version"`
+	Site          types.String `tfsdk:"site"`
+}
+
+type datastorePrometheusModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (r *datastoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datastore"
+}
+
+func (r *datastoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a datastore instance",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for this datastore (dbi-...)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the datastore instance",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 55),
+				},
+			},
+			"template": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the datastore template used for provisioning",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the datastore (spanner, postgres or cockroachdb)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_region": schema.StringAttribute{
+				Computed:    true,
+				Description: "Region where the datastore is hosted",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_cpus": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of virtual CPUs allocated to the datastore",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cockroachdb": schema.SingleNestedBlock{
+				Description: "CockroachDB cluster specification overriding the template defaults",
+				Attributes: map[string]schema.Attribute{
+					"cloud_provider": schema.StringAttribute{
+						Optional:    true,
+						Description: "Cloud provider hosting the cluster",
+					},
+					"cluster_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Name of the cluster",
+					},
+					"plan": schema.StringAttribute{
+						Optional:    true,
+						Description: "Cluster plan",
+					},
+					"region_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Region of the cluster",
+					},
+					"parent_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "Parent ID of the cluster",
+					},
+					"secret_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Name of the secret holding the cluster credentials",
+					},
+					"labels": schema.MapAttribute{
+						Optional:    true,
+						Description: "Labels applied to the cluster",
+						ElementType: types.StringType,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"configuration": schema.SingleNestedBlock{
+						Description: "Cluster configuration",
+						Attributes: map[string]schema.Attribute{
+							"delete_protection": schema.StringAttribute{
+								Optional:    true,
+								Description: "Delete protection setting for the cluster",
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"dedicated": schema.SingleNestedBlock{
+								Description: "Dedicated cluster specification",
+								Attributes: map[string]schema.Attribute{
+									"num_virtual_cpus": schema.Int64Attribute{
+										Optional:    true,
+										Description: "Number of virtual CPUs per node",
+									},
+									"region_nodes": schema.MapAttribute{
+										Optional:    true,
+										Description: "Map of region name to node count",
+										ElementType: types.Int64Type,
+									},
+									"storage_gib": schema.Int64Attribute{
+										Optional:    true,
+										Description: "Storage per node in GiB",
+									},
+								},
+							},
+							"serverless": schema.SingleNestedBlock{
+								Description: "Serverless cluster specification",
+								Attributes: map[string]schema.Attribute{
+									"primary_region": schema.StringAttribute{
+										Optional:    true,
+										Description: "Primary region of the cluster",
+									},
+									"regions": schema.ListAttribute{
+										Optional:    true,
+										Description: "Regions of the cluster",
+										ElementType: types.StringType,
+									},
+									"upgrade_type": schema.StringAttribute{
+										Optional:    true,
+										Description: "Upgrade type of the cluster",
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"usage_limits": schema.SingleNestedBlock{
+										Description: "Usage limits for the serverless cluster",
+										Attributes: map[string]schema.Attribute{
+											"provisioned_virtual_cpus": schema.Int64Attribute{
+												Optional:    true,
+												Description: "Provisioned virtual CPUs",
+											},
+											"request_unit_limit": schema.Int64Attribute{
+												Optional:    true,
+												Description: "Request unit limit",
+											},
+											"storage_mib_limit": schema.Int64Attribute{
+												Optional:    true,
+												Description: "Storage limit in MiB",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"metric_export": schema.SingleNestedBlock{
+						Description: "Metric export configuration for the cluster",
+						Blocks: map[string]schema.Block{
+							"cloudwatch": schema.SingleNestedBlock{
+								Description: "CloudWatch metric export",
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether CloudWatch export is enabled",
+									},
+									"external_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "External ID used when assuming the role",
+									},
+									"log_group_name": schema.StringAttribute{
+										Optional:    true,
+										Description: "CloudWatch log group name",
+									},
+									"role_arn": schema.StringAttribute{
+										Optional:    true,
+										Description: "ARN of the IAM role used for export",
+									},
+									"target_region": schema.StringAttribute{
+										Optional:    true,
+										Description: "AWS region receiving the metrics",
+									},
+								},
+							},
+							"datadog": schema.SingleNestedBlock{
+								Description: "Datadog metric export",
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether Datadog export is enabled",
+									},
+									"api_key": schema.StringAttribute{
+										Optional:    true,
+										WriteOnly:   true,
+										Sensitive:   true,
+										Description: "Datadog API key. This value is write-only and never stored in state; change api_key_version to send a new key",
+									},
+									"api_key_version": schema.StringAttribute{
+										Optional:    true,
+										Description: "Arbitrary value that triggers the API key to be sent again when changed",
+									},
+									"site": schema.StringAttribute{
+										Optional:    true,
+										Description: "Datadog site associated with the API key",
+									},
+								},
+							},
+							"prometheus": schema.SingleNestedBlock{
+								Description: "Prometheus metric export",
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether Prometheus export is enabled",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *datastoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan evaluates the CEL validations of the template against the cockroachdb block,
+// so that invalid overrides fail at plan time instead of after the API round-trip on apply
+func (r *datastoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or when the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Skip the API call when nothing changed
+	if !req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan datastoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CockroachDB == nil || plan.Template.IsUnknown() {
+		return
+	}
+
+	// Values known only after apply would be validated as empty, so wait until they are known
+	var cockroachDB types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cockroachdb"), &cockroachDB)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cockroachDBValue, err := cockroachDB.ToTerraformValue(ctx)
+	if err != nil || !cockroachDBValue.IsFullyKnown() {
+		return
+	}
+
+	templates, err := r.client.ListDatastoreTemplates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Datastore Template Overrides",
+			fmt.Sprintf("Unable to list datastore templates, the overrides will be validated on apply: %s", err),
+		)
+		return
+	}
+
+	template, ok := findDatastoreTemplate(templates, plan.Template.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template"),
+			"Unknown Datastore Template",
+			fmt.Sprintf("Template %q does not exist. Available templates: %s", plan.Template.ValueString(), datastoreTemplateNames(templates)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.CockroachDB.readDatadogAPIKey(ctx, req.Config)...)
+	clusterSpec, diags := plan.CockroachDB.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDatastoreTemplateOverrides(template, clusterSpec)...)
+}
+
+func (r *datastoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data datastoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available from the configuration
+	resp.Diagnostics.Append(data.CockroachDB.readDatadogAPIKey(ctx, req.Config)...)
+	clusterSpec, diags := data.CockroachDB.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datastore := &models.CreateDatastoreRequest{
+		Name:        data.Name.ValueString(),
+		Template:    data.Template.ValueString(),
+		CockroachDB: clusterSpec,
+	}
+
+	createdDatastore, err := r.client.CreateDatastore(ctx, datastore)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create datastore, got error: %s", err))
+		return
+	}
+
+	setDatastoreResourceData(&data, createdDatastore.Datastore)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the datastore state. The API does not return the cluster
+// specification, so the cockroachdb block is kept as configured.
+func (r *datastoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data datastoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datastoreWithETag, err := r.client.GetDatastore(ctx, data.ID.ValueString())
+	if err != nil {
+		apiErr := &client.APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// Datastore was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read datastore, got error: %s", err))
+		return
+	}
+
+	setDatastoreResourceData(&data, datastoreWithETag.Datastore)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *datastoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data datastoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state datastoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The whole cluster specification is sent on every update, including the write-only API key
+	resp.Diagnostics.Append(data.CockroachDB.readDatadogAPIKey(ctx, req.Config)...)
+	clusterSpec, diags := data.CockroachDB.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update := &models.UpdateDatastoreRequest{
+		Name:        data.Name.ValueString(),
+		CockroachDB: clusterSpec,
+	}
+
+	updatedDatastore, err := r.client.UpdateDatastore(ctx, state.ID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update datastore, got error: %s", err))
+		return
+	}
+
+	setDatastoreResourceData(&data, updatedDatastore.Datastore)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *datastoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data datastoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API accepts the delete asynchronously (202), the client polls until the datastore is gone
+	if err := r.client.DeleteDatastore(data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting datastore", fmt.Sprintf("Unable to delete datastore: %v", err))
+		return
+	}
+}
+
+// ImportState handles importing an existing datastore into Terraform state
+func (r *datastoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setDatastoreResourceData maps an API datastore onto the resource model. The Datadog API key
+// is write-only and always left null in state.
+func setDatastoreResourceData(data *datastoreResourceModel, datastore *models.Datastore) {
+	data.ID = types.StringValue(datastore.ID)
+	data.Name = types.StringValue(datastore.Name)
+	// Only fill in the template on import, to avoid spurious replacements if the API normalizes the name
+	if data.Template.IsNull() && datastore.TemplateName != "" {
+		data.Template = types.StringValue(datastore.TemplateName)
+	}
+	data.Type = types.StringValue(datastore.Type)
+	data.ProviderRegion = types.StringValue(datastore.ProviderRegion)
+	if datastore.Compute != nil {
+		data.VirtualCPUs = types.Int64Value(datastore.Compute.VirtualCPUs)
+	} else {
+		data.VirtualCPUs = types.Int64Null()
+	}
+	if m := data.CockroachDB; m != nil && m.MetricExport != nil && m.MetricExport.Datadog != nil {
+		m.MetricExport.Datadog.APIKey = types.StringNull()
+	}
+}
+
+// readDatadogAPIKey copies the write-only Datadog API key from the configuration into the
+// model, as it is always null in the plan
+func (m *datastoreClusterSpecModel) readDatadogAPIKey(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	if m == nil || m.MetricExport == nil || m.MetricExport.Datadog == nil {
+		return nil
+	}
+
+	var apiKey types.String
+	diags := config.GetAttribute(ctx, path.Root("cockroachdb").AtName("metric_export").AtName("datadog").AtName("api_key"), &apiKey)
+	m.MetricExport.Datadog.APIKey = apiKey
+	return diags
+}
+
+// toAPI converts the cockroachdb block into the API cluster specification
+func (m *datastoreClusterSpecModel) toAPI(ctx context.Context) (*models.ClusterSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if m == nil {
+		return nil, diags
+	}
+
+	spec := &models.ClusterSpec{
+		CloudProvider: m.CloudProvider.ValueString(),
+		ClusterName:   m.ClusterName.ValueString(),
+		Plan:          m.Plan.ValueString(),
+		RegionName:    m.RegionName.ValueString(),
+		ParentID:      m.ParentID.ValueString(),
+		SecretName:    m.SecretName.ValueString(),
+	}
+
+	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
+		labels := make(map[string]string)
+		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+		spec.Labels = labels
+	}
+
+	if cfg := m.Configuration; cfg != nil {
+		spec.Configuration = &models.ClusterConfiguration{}
+		if !cfg.DeleteProtection.IsNull() && !cfg.DeleteProtection.IsUnknown() {
+			deleteProtection := cfg.DeleteProtection.ValueString()
+			spec.Configuration.DeleteProtection = &deleteProtection
+		}
+
+		if dedicated := cfg.Dedicated; dedicated != nil {
+			spec.Configuration.Dedicated = &models.DedicatedSpecification{
+				NumVirtualCPUs: dedicated.NumVirtualCPUs.ValueInt64(),
+				StorageGib:     dedicated.StorageGib.ValueInt64(),
+			}
+			if !dedicated.RegionNodes.IsNull() && !dedicated.RegionNodes.IsUnknown() {
+				regionNodes := make(map[string]int64)
+				diags.Append(dedicated.RegionNodes.ElementsAs(ctx, &regionNodes, false)...)
+				spec.Configuration.Dedicated.RegionNodes = regionNodes
+			}
+		}
+
+		if serverless := cfg.Serverless; serverless != nil {
+			spec.Configuration.Serverless = &models.ServerlessSpecification{
+				PrimaryRegion: serverless.PrimaryRegion.ValueString(),
+				UpgradeType:   serverless.UpgradeType.ValueString(),
+			}
+			if !serverless.Regions.IsNull() && !serverless.Regions.IsUnknown() {
+				var regions []string
+				diags.Append(serverless.Regions.ElementsAs(ctx, &regions, false)...)
+				spec.Configuration.Serverless.Regions = regions
+			}
+			if limits := serverless.UsageLimits; limits != nil {
+				spec.Configuration.Serverless.UsageLimits = &models.UsageLimits{
+					ProvisionedVirtualCPUs: limits.ProvisionedVirtualCPUs.ValueInt64(),
+					RequestUnitLimit:       limits.RequestUnitLimit.ValueInt64(),
+					StorageMibLimit:        limits.StorageMibLimit.ValueInt64(),
+				}
+			}
+		}
+	}
+
+	if export := m.MetricExport; export != nil {
+		spec.MetricExport = &models.MetricExportConfiguration{}
+		if cw := export.CloudWatch; cw != nil {
+			spec.MetricExport.CloudWatch = &models.CloudWatchMetricExport{
+				Enabled:      cw.Enabled.ValueBool(),
+				ExternalID:   cw.ExternalID.ValueString(),
+				LogGroupName: cw.LogGroupName.ValueString(),
+				RoleArn:      cw.RoleArn.ValueString(),
+				TargetRegion: cw.TargetRegion.ValueString(),
+			}
+		}
+		if dd := export.Datadog; dd != nil {
+			spec.MetricExport.Datadog = &models.DatadogMetricExport{
+				Enabled: dd.Enabled.ValueBool(),
+				APIKey:  dd.APIKey.ValueString(),
+				Site:    dd.Site.ValueString(),
+			}
+		}
+		if prom := export.Prometheus; prom != nil {
+			spec.MetricExport.Prometheus = &models.PrometheusMetricExport{
+				Enabled: prom.Enabled.ValueBool(),
+			}
+		}
+	}
+
+	return spec, diags
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cel.dev/cel-go/cel"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// datastoreTemplateCELEnv declares the variables available to template validation expressions:
+//   - self: the effective cluster specification, i.e. the template defaults merged with the overrides
+//   - overrides: the cluster specification as configured in the cockroachdb block
+//   - defaults: the template defaults
+//
+// The API spec (DatastoreTemplateValidation in openapi-spec.yaml) does not define the bindings, so these
+// are the provider's contract, documented on the authzed_datastore resource. Expressions referencing
+// other variables fail to compile and are reported as errors rather than silently skipped.
+func datastoreTemplateCELEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("self", cel.DynType),
+		cel.Variable("overrides", cel.DynType),
+		cel.Variable("defaults", cel.DynType),
+	)
+}
+
+// validateDatastoreTemplateOverrides evaluates the CEL validations of a template against the planned
+// cluster specification. Failing expressions are reported as errors on the cockroachdb block, together
+// with the reason of the template. Expressions that don't compile are reported as errors, as they point
+// to a template the provider can't validate. Expressions that fail to evaluate, e.g. because they
+// reference fields that aren't set, are reported as warnings and left for the API to enforce.
+func validateDatastoreTemplateOverrides(template models.DatastoreTemplate, spec *models.ClusterSpec) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(template.Validations) == 0 {
+		return diags
+	}
+
+	overrides, err := toCELValue(spec)
+	if err != nil {
+		diags.AddWarning("Unable to Validate Datastore Template Overrides", fmt.Sprintf("Unable to convert the cockroachdb block: %s", err))
+		return diags
+	}
+	defaults, err := toCELValue(template.Defaults)
+	if err != nil {
+		diags.AddWarning("Unable to Validate Datastore Template Overrides", fmt.Sprintf("Unable to convert the defaults of template %s: %s", template.Name, err))
+		return diags
+	}
+
+	env, err := datastoreTemplateCELEnv()
+	if err != nil {
+		diags.AddWarning("Unable to Validate Datastore Template Overrides", fmt.Sprintf("Unable to create CEL environment: %s", err))
+		return diags
+	}
+
+	activation := map[string]any{
+		"self":      mergeCELValues(defaults, overrides),
+		"overrides": overrides,
+		"defaults":  defaults,
+	}
+
+	for _, validation := range template.Validations {
+		ast, issues := env.Compile(validation.Expression)
+		if issues != nil && issues.Err() != nil {
+			diags.AddAttributeError(
+				path.Root("cockroachdb"),
+				"Unable to Compile Datastore Template Validation",
+				fmt.Sprintf("Validation %q of template %s is not a valid CEL expression over self, overrides and defaults: %s", validation.Expression, template.Name, issues.Err()),
+			)
+			continue
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("cockroachdb"),
+				"Unable to Compile Datastore Template Validation",
+				fmt.Sprintf("Validation %q of template %s is not a valid CEL expression over self, overrides and defaults: %s", validation.Expression, template.Name, err),
+			)
+			continue
+		}
+
+		result, _, err := program.Eval(activation)
+		if err != nil {
+			diags.AddWarning(
+				"Unable to Evaluate Datastore Template Validation",
+				fmt.Sprintf("Skipping validation %q of template %s: %s", validation.Expression, template.Name, err),
+			)
+			continue
+		}
+
+		passed, ok := result.Value().(bool)
+		if !ok {
+			diags.AddWarning(
+				"Unable to Evaluate Datastore Template Validation",
+				fmt.Sprintf("Skipping validation %q of template %s: expected a bool result, got %s", validation.Expression, template.Name, result.Type().TypeName()),
+			)
+			continue
+		}
+
+		if !passed {
+			reason := validation.Reason
+			if reason == "" {
+				reason = "the configuration does not satisfy the template"
+			}
+			diags.AddAttributeError(
+				path.Root("cockroachdb"),
+				"Invalid Datastore Template Override",
+				fmt.Sprintf("Template %s rejects this configuration: %s\n\nFailed validation: %s", template.Name, reason, validation.Expression),
+			)
+		}
+	}
+
+	return diags
+}
+
+// findDatastoreTemplate returns the template with the given name
+func findDatastoreTemplate(templates []models.DatastoreTemplate, name string) (models.DatastoreTemplate, bool) {
+	for _, template := range templates {
+		if template.Name == name {
+			return template, true
+		}
+	}
+	return models.DatastoreTemplate{}, false
+}
+
+// datastoreTemplateNames returns the sorted names of the templates, for error messages
+func datastoreTemplateNames(templates []models.DatastoreTemplate) string {
+	names := make([]string, 0, len(templates))
+	for _, template := range templates {
+		names = append(names, template.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// toCELValue converts a value into plain JSON maps, lists and scalars that CEL can evaluate.
+// Whole numbers become int64, so that expressions can compare them with integer literals.
+func toCELValue(value any) (any, error) {
+	if value == nil {
+		return map[string]any{}, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	if decoded == nil {
+		return map[string]any{}, nil
+	}
+	return normalizeJSONNumbers(decoded), nil
+}
+
+func normalizeJSONNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeJSONNumbers(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeJSONNumbers(item)
+		}
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// mergeCELValues deep merges overrides into defaults. Nested objects are merged key by key,
+// any other override value replaces the default.
+func mergeCELValues(defaults, overrides any) any {
+	defaultsMap, ok := defaults.(map[string]any)
+	if !ok {
+		return overrides
+	}
+	overridesMap, ok := overrides.(map[string]any)
+	if !ok {
+		return overrides
+	}
+
+	merged := make(map[string]any, len(defaultsMap)+len(overridesMap))
+	for key, value := range defaultsMap {
+		merged[key] = value
+	}
+	for key, value := range overridesMap {
+		if existing, ok := merged[key]; ok {
+			merged[key] = mergeCELValues(existing, value)
+		} else {
+			merged[key] = value
+		}
+	}
+	return merged
+}
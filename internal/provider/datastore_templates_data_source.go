@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var _ datasource.DataSourceWithConfigure = &datastoreTemplatesDataSource{}
+
+func NewDatastoreTemplatesDataSource() datasource.DataSource {
+	return &datastoreTemplatesDataSource{}
+}
+
+type datastoreTemplatesDataSource struct {
+	client *client.CloudClient
+}
+
+// datastoreTemplatesDataSourceModel maps the data source schema to values.
+// Dynamic values can't be nested in lists, so defaults and allow_overrides are
+// exposed as objects keyed by template name.
+type datastoreTemplatesDataSourceModel struct {
+	ID             types.String             `tfsdk:"id"`
+	Templates      []datastoreTemplateModel `tfsdk:"templates"`
+	Defaults       types.Dynamic            `tfsdk:"defaults"`
+	AllowOverrides types.Dynamic            `tfsdk:"allow_overrides"`
+}
+
+type datastoreTemplateModel struct {
+	Name        types.String                       `tfsdk:"name"`
+	Provider    types.String                       `tfsdk:"provider"`
+	DisplayName types.String                       `tfsdk:"display_name"`
+	Description types.String                       `tfsdk:"description"`
+	Validations []datastoreTemplateValidationModel `tfsdk:"validations"`
+}
+
+type datastoreTemplateValidationModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Reason     types.String `tfsdk:"reason"`
+}
+
+func (d *datastoreTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datastore_templates"
+}
+
+func (d *datastoreTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the datastore templates available for provisioning",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for the data source",
+			},
+			"templates": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of datastore templates",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the template",
+						},
+						"provider": schema.StringAttribute{
+							Computed:    true,
+							Description: "Database provider of the template (e.g., cockroachdb)",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human-readable display name of the template",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the template",
+						},
+						"validations": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "CEL expressions a datastore configuration must satisfy",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"expression": schema.StringAttribute{
+										Computed:    true,
+										Description: "CEL validation expression",
+									},
+									"reason": schema.StringAttribute{
+										Computed:    true,
+										Description: "Human-readable reason reported when the validation fails",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"defaults": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "Default configuration values of each template, keyed by template name",
+			},
+			"allow_overrides": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "Configuration fields each template allows to be overridden, keyed by template name",
+			},
+		},
+	}
+}
+
+func (d *datastoreTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *datastoreTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datastoreTemplatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := d.client.ListDatastoreTemplates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list datastore templates, got error: %s", err))
+		return
+	}
+
+	// Set ID for the data source
+	data.ID = types.StringValue("all_datastore_templates")
+
+	resp.Diagnostics.Append(setDatastoreTemplatesData(ctx, &data, templates)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setDatastoreTemplatesData maps the API templates onto the data source model
+func setDatastoreTemplatesData(ctx context.Context, data *datastoreTemplatesDataSourceModel, templates []models.DatastoreTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	templateList := make([]datastoreTemplateModel, 0, len(templates))
+	defaults := make(map[string]any, len(templates))
+	allowOverrides := make(map[string]any, len(templates))
+	for _, template := range templates {
+		validations := make([]datastoreTemplateValidationModel, 0, len(template.Validations))
+		for _, validation := range template.Validations {
+			validations = append(validations, datastoreTemplateValidationModel{
+				Expression: types.StringValue(validation.Expression),
+				Reason:     types.StringValue(validation.Reason),
+			})
+		}
+
+		templateList = append(templateList, datastoreTemplateModel{
+			Name:        types.StringValue(template.Name),
+			Provider:    types.StringValue(template.Provider),
+			DisplayName: types.StringValue(template.DisplayName),
+			Description: types.StringValue(template.Description),
+			Validations: validations,
+		})
+
+		defaults[template.Name] = template.Defaults
+		allowOverrides[template.Name] = template.AllowOverrides
+	}
+	data.Templates = templateList
+
+	defaultsValue, convertDiags := jsonToDynamic(ctx, defaults)
+	diags.Append(convertDiags...)
+	data.Defaults = defaultsValue
+
+	allowOverridesValue, convertDiags := jsonToDynamic(ctx, allowOverrides)
+	diags.Append(convertDiags...)
+	data.AllowOverrides = allowOverridesValue
+
+	return diags
+}
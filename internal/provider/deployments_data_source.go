@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var _ datasource.DataSourceWithConfigure = &deploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &deploymentsDataSource{}
+}
+
+type deploymentsDataSource struct {
+	client *client.CloudClient
+}
+
+// deploymentsDataSourceModel maps the data source schema to values
+type deploymentsDataSourceModel struct {
+	ID                  types.String      `tfsdk:"id"`
+	PermissionsSystemID types.String      `tfsdk:"permission_system_id"`
+	RequireRunning      types.Bool        `tfsdk:"require_running"`
+	AllRunning          types.Bool        `tfsdk:"all_running"`
+	Deployments         []deploymentModel `tfsdk:"deployments"`
+}
+
+type deploymentModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	DeploymentTemplateID types.String `tfsdk:"deployment_template_id"`
+	DNSName              types.String `tfsdk:"dns_name"`
+	RegionID             types.String `tfsdk:"region_id"`
+	Replicas             types.Int64  `tfsdk:"replicas"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	AWSAccountID         types.String `tfsdk:"aws_account_id"`
+	GCPServiceAccount    types.String `tfsdk:"gcp_service_account"`
+	Status               types.String `tfsdk:"status"`
+	Message              types.String `tfsdk:"message"`
+}
+
+func (d *deploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *deploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the deployments of a permission system and their health",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for the data source",
+			},
+			"permission_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the permission system",
+			},
+			"require_running": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, reading the data source fails unless every deployment is RUNNING",
+			},
+			"all_running": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether every deployment is RUNNING",
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of deployments",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the deployment",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the deployment",
+						},
+						"deployment_template_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the deployment template used by the deployment",
+						},
+						"dns_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "DNS name of the deployment",
+						},
+						"region_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the region of the deployment",
+						},
+						"replicas": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of replicas of the deployment",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the deployment was created",
+						},
+						"aws_account_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "AWS account ID associated with the deployment, empty if not applicable",
+						},
+						"gcp_service_account": schema.StringAttribute{
+							Computed:    true,
+							Description: "GCP service account associated with the deployment, empty if not applicable",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the deployment (e.g., RUNNING, MIGRATING, VALIDATION_FAILED)",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Message associated with the status",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *deploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data deploymentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use permission system ID as the data source ID
+	data.ID = data.PermissionsSystemID
+
+	deployments, err := d.client.ListDeployments(ctx, data.PermissionsSystemID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list deployments, got error: %s", err))
+		return
+	}
+
+	deploymentList := make([]deploymentModel, 0, len(deployments))
+	for _, deployment := range deployments {
+		deploymentList = append(deploymentList, deploymentModel{
+			ID:                   types.StringValue(deployment.ID),
+			Name:                 types.StringValue(deployment.Name),
+			DeploymentTemplateID: types.StringValue(deployment.DeploymentTemplateID),
+			DNSName:              types.StringValue(deployment.DNSName),
+			RegionID:             types.StringValue(deployment.RegionID),
+			Replicas:             types.Int64Value(deployment.Replicas),
+			CreatedAt:            types.StringValue(deployment.CreatedAt),
+			AWSAccountID:         types.StringValue(deployment.CloudProviderDetails.AWSAccountID),
+			GCPServiceAccount:    types.StringValue(deployment.CloudProviderDetails.GCPServiceAccount),
+			Status:               types.StringValue(deployment.SystemState.Status),
+			Message:              types.StringValue(deployment.SystemState.Message),
+		})
+	}
+	data.Deployments = deploymentList
+
+	notRunning := deploymentsNotRunning(deployments)
+	data.AllRunning = types.BoolValue(len(notRunning) == 0)
+
+	if data.RequireRunning.ValueBool() && len(notRunning) > 0 {
+		resp.Diagnostics.AddError(
+			"Deployments Not Running",
+			fmt.Sprintf("require_running is set, but the following deployments of permission system %s are not RUNNING:\n%s",
+				data.PermissionsSystemID.ValueString(), strings.Join(notRunning, "\n")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// deploymentsNotRunning describes every deployment whose status is not RUNNING
+func deploymentsNotRunning(deployments []models.Deployment) []string {
+	var notRunning []string
+	for _, deployment := range deployments {
+		if deployment.SystemState.Status == "RUNNING" {
+			continue
+		}
+		description := fmt.Sprintf("  - %s (%s): %s", deployment.ID, deployment.Name, deployment.SystemState.Status)
+		if deployment.SystemState.Message != "" {
+			description += " - " + deployment.SystemState.Message
+		}
+		notRunning = append(notRunning, description)
+	}
+	return notRunning
+}
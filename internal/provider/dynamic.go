@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonToDynamic converts a decoded JSON document into a dynamic value. Objects become
+// object values and arrays become tuples, so documents with mixed types are preserved.
+func jsonToDynamic(ctx context.Context, value any) (types.Dynamic, diag.Diagnostics) {
+	if value == nil {
+		return types.DynamicNull(), nil
+	}
+
+	converted, diags := jsonToAttrValue(ctx, value)
+	if diags.HasError() {
+		return types.DynamicNull(), diags
+	}
+	return types.DynamicValue(converted), diags
+}
+
+// jsonToAttrValue converts a decoded JSON value into the matching attr.Value
+func jsonToAttrValue(ctx context.Context, value any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch v := value.(type) {
+	case nil:
+		// Nested nulls need a concrete type, a null string is the closest match
+		return types.StringNull(), diags
+	case bool:
+		return types.BoolValue(v), diags
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), diags
+	case string:
+		return types.StringValue(v), diags
+	case []any:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			elem, elemDiags := jsonToAttrValue(ctx, item)
+			diags.Append(elemDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			elemTypes = append(elemTypes, elem.Type(ctx))
+			elems = append(elems, elem)
+		}
+		tuple, tupleDiags := types.TupleValue(elemTypes, elems)
+		diags.Append(tupleDiags...)
+		return tuple, diags
+	case map[string]any:
+		return jsonObjectToAttrValue(ctx, v)
+	default:
+		diags.AddError("Unsupported JSON Value", fmt.Sprintf("Unable to convert value of type %T", value))
+		return nil, diags
+	}
+}
+
+// jsonObjectToAttrValue converts a decoded JSON object into an object value
+func jsonObjectToAttrValue(ctx context.Context, object map[string]any) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attrTypes := make(map[string]attr.Type, len(object))
+	attrs := make(map[string]attr.Value, len(object))
+	for _, key := range keys {
+		value, valueDiags := jsonToAttrValue(ctx, object[key])
+		diags.Append(valueDiags...)
+		if diags.HasError() {
+			return types.ObjectNull(nil), diags
+		}
+		attrTypes[key] = value.Type(ctx)
+		attrs[key] = value
+	}
+
+	objectValue, objectDiags := types.ObjectValue(attrTypes, attrs)
+	diags.Append(objectDiags...)
+	return objectValue, diags
+}
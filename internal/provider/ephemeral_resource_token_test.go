@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/provider/pslanes"
+)
+
+func TestTokenEphemeralResource_OpenClose(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-123/tokens":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", "etag-1")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":                  "atk-123",
+				"name":                "ci",
+				"permissionsSystemID": "ps-123",
+				"serviceAccountID":    "asa-123",
+				"createdAt":           "2026-01-01T00:00:00Z",
+				"hash":                "abc",
+				"secret":              "sdbst_secret",
+			})
+		case r.Method == http.MethodDelete:
+			mu.Lock()
+			deleted = append(deleted, r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	providerServer, schemaResp := testConfiguredProviderServer(t, server.URL)
+
+	tokenSchema := schemaResp.EphemeralResourceSchemas["authzed_token"]
+	if tokenSchema == nil {
+		t.Fatal("Expected the authzed_token ephemeral resource to be registered")
+	}
+
+	openResp, err := providerServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "authzed_token",
+		Config: testDynamicValue(t, tokenSchema, map[string]tftypes.Value{
+			"name":                 tftypes.NewValue(tftypes.String, "ci"),
+			"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123"),
+			"service_account_id":   tftypes.NewValue(tftypes.String, "asa-123"),
+		}),
+	})
+	if err != nil || len(openResp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error opening token: %v %v", err, openResp.Diagnostics)
+	}
+
+	result, err := openResp.Result.Unmarshal(tokenSchema.ValueType())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := result.As(&attributes); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var plainText, id string
+	if err := attributes["plain_text"].As(&plainText); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := attributes["id"].As(&id); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if plainText != "sdbst_secret" || id != "atk-123" {
+		t.Errorf("Expected token atk-123 with its secret, got %q %q", id, plainText)
+	}
+
+	closeResp, err := providerServer.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "authzed_token",
+		Private:  openResp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error closing token: %v %v", err, closeResp.Diagnostics)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(deleted) != 1 || deleted[0] != "/ps/ps-123/access/service-accounts/asa-123/tokens/atk-123" {
+		t.Errorf("Expected the token to be deleted on close, got %v", deleted)
+	}
+}
+
+func TestTokenEphemeralResource_OpenWithoutSecret(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+	token := map[string]any{
+		"id":                  "atk-123",
+		"name":                "ci",
+		"permissionsSystemID": "ps-123",
+		"serviceAccountID":    "asa-123",
+		"createdAt":           "2026-01-01T00:00:00Z",
+		"hash":                "abc",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-123/tokens":
+			// Time out the create, so that the client recovers the token by name without its secret
+			time.Sleep(200 * time.Millisecond)
+		case r.Method == http.MethodGet && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-123/tokens":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{token}})
+		case r.Method == http.MethodGet && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-123/tokens/atk-123":
+			w.Header().Set("ETag", "etag-1")
+			_ = json.NewEncoder(w).Encode(token)
+		case r.Method == http.MethodDelete:
+			mu.Lock()
+			deleted = append(deleted, r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cloudClient := client.NewCloudClient(&client.CloudClientConfig{Host: server.URL, Token: "test-token"})
+	cloudClient.HTTPClient.Transport = &http.Transport{ResponseHeaderTimeout: 50 * time.Millisecond}
+	r := &TokenEphemeralResource{
+		client:  cloudClient,
+		psLanes: pslanes.NewPSLanes(),
+	}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config["name"] = tftypes.NewValue(tftypes.String, "ci")
+	config["permission_system_id"] = tftypes.NewValue(tftypes.String, "ps-123")
+	config["service_account_id"] = tftypes.NewValue(tftypes.String, "asa-123")
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a token without a secret")
+
+	}
+
+	// Terraform does not call Close after a failed Open, so the token must already be deleted
+	mu.Lock()
+	defer mu.Unlock()
+	if len(deleted) != 1 || deleted[0] != "/ps/ps-123/access/service-accounts/asa-123/tokens/atk-123" {
+		t.Errorf("Expected the token to be deleted by Open, got %v", deleted)
+	}
+}
+
+func TestTokenEphemeralResource_OpenFailsAfterCreate(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/ps/ps-123/access/service-accounts/asa-123/tokens":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", "etag-1")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":                  "atk-123",
+				"name":                "ci",
+				"permissionsSystemID": "ps-123",
+				"serviceAccountID":    "asa-123",
+				"createdAt":           "2026-01-01T00:00:00Z",
+				"hash":                "abc",
+				"secret":              "sdbst_secret",
+			})
+		case r.Method == http.MethodDelete:
+			mu.Lock()
+			deleted = append(deleted, r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &TokenEphemeralResource{
+		client:  client.NewCloudClient(&client.CloudClientConfig{Host: server.URL, Token: "test-token"}),
+		psLanes: pslanes.NewPSLanes(),
+	}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config["name"] = tftypes.NewValue(tftypes.String, "ci")
+	config["permission_system_id"] = tftypes.NewValue(tftypes.String, "ps-123")
+	config["service_account_id"] = tftypes.NewValue(tftypes.String, "asa-123")
+
+	// Without private state, storing the token identifiers fails after the token was created
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error when the private data cannot be stored")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(deleted) != 1 || deleted[0] != "/ps/ps-123/access/service-accounts/asa-123/tokens/atk-123" {
+		t.Errorf("Expected the token to be deleted by Open, got %v", deleted)
+	}
+}
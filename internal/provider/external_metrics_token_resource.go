@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var (
+	_ resource.Resource                = &externalMetricsTokenResource{}
+	_ resource.ResourceWithImportState = &externalMetricsTokenResource{}
+	_ resource.ResourceWithModifyPlan  = &externalMetricsTokenResource{}
+)
+
+func NewExternalMetricsTokenResource() resource.Resource {
+	return &externalMetricsTokenResource{}
+}
+
+type externalMetricsTokenResource struct {
+	client *client.CloudClient
+}
+
+type externalMetricsTokenResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	RotationTrigger     types.Map    `tfsdk:"rotation_trigger"`
+	Secret              types.String `tfsdk:"secret"`
+	Hash                types.String `tfsdk:"hash"`
+}
+
+func (r *externalMetricsTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_metrics_token"
+}
+
+func (r *externalMetricsTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a token used to export permission system metrics to Prometheus",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for this token (em-...)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the permission system whose metrics are exported",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": schema.MapAttribute{
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, rotates the token secret in place",
+				ElementType: types.StringType,
+			},
+			"secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret of the token. Only available after creation or rotation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 hash of the secret part of the token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *externalMetricsTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan marks the secret and hash as unknown when rotation_trigger changes,
+// since the update rotates the secret in place
+func (r *externalMetricsTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state externalMetricsTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationTrigger.Equal(state.RotationTrigger) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hash"), types.StringUnknown())...)
+}
+
+func (r *externalMetricsTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data externalMetricsTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.CreateExternalMetricsToken(ctx, data.PermissionsSystemID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create external metrics token, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(token.ID)
+	data.Secret = types.StringValue(token.Secret)
+	data.Hash = types.StringValue(r.lookupHash(ctx, data.PermissionsSystemID.ValueString(), token.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read detects deleted tokens by comparing the hash returned by the list endpoint
+func (r *externalMetricsTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data externalMetricsTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens, err := r.client.ListExternalMetricsTokens(ctx, data.PermissionsSystemID.ValueString())
+	if err != nil {
+		apiErr := &client.APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// Permission system was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list external metrics tokens, got error: %s", err))
+		return
+	}
+
+	hash, exists := findExternalMetricsTokenHash(tokens, data.ID.ValueString())
+	if !exists {
+		// Token was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	switch {
+	case data.Hash.ValueString() == "":
+		// The hash was not yet listed when the token was created, or the token was imported
+		data.Hash = types.StringValue(hash)
+	case data.Hash.ValueString() != hash:
+		// The secret in state no longer works, so drop it for consumers to see the change in the plan
+		resp.Diagnostics.AddWarning(
+			"External Metrics Token Rotated Outside of Terraform",
+			fmt.Sprintf("The secret of external metrics token %s was rotated outside of Terraform and was removed from state. Change rotation_trigger to rotate the token and store a new secret.", data.ID.ValueString()),
+		)
+		data.Secret = types.StringNull()
+		data.Hash = types.StringValue(hash)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update rotates the secret in place when rotation_trigger changes
+func (r *externalMetricsTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data externalMetricsTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state externalMetricsTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	if data.RotationTrigger.Equal(state.RotationTrigger) {
+		data.Secret = state.Secret
+		data.Hash = state.Hash
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	secret, err := r.client.RotateExternalMetricsToken(ctx, state.PermissionsSystemID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate external metrics token, got error: %s", err))
+		return
+	}
+
+	data.Secret = types.StringValue(secret)
+	data.Hash = types.StringValue(r.lookupHash(ctx, state.PermissionsSystemID.ValueString(), state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the token from state. The API does not support deleting
+// external metrics tokens, so the token remains valid.
+func (r *externalMetricsTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data externalMetricsTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"External Metrics Token Not Deleted",
+		fmt.Sprintf("External metrics token %s was removed from Terraform state, but the API does not support deleting it and it remains valid.", data.ID.ValueString()),
+	)
+}
+
+// ImportState handles importing an external metrics token using 'permission_system_id:token_id'.
+// The secret cannot be recovered on import.
+func (r *externalMetricsTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import id in format 'permission_system_id:token_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hash"), "")...)
+}
+
+// lookupHash returns the listed hash of a token, or an empty string if it can't be listed yet.
+// Read fills in an empty hash on the next refresh.
+func (r *externalMetricsTokenResource) lookupHash(ctx context.Context, permissionsSystemID, tokenID string) string {
+	tokens, err := r.client.ListExternalMetricsTokens(ctx, permissionsSystemID)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to list external metrics tokens after write", map[string]any{
+			"token_id": tokenID,
+			"error":    err.Error(),
+		})
+		return ""
+	}
+
+	hash, _ := findExternalMetricsTokenHash(tokens, tokenID)
+	return hash
+}
+
+// findExternalMetricsTokenHash returns the hash of the token with the given ID and whether the token still exists.
+// A token with an empty hash no longer exists.
+func findExternalMetricsTokenHash(tokens []models.ExternalMetricsTokenListItem, tokenID string) (string, bool) {
+	for _, token := range tokens {
+		if token.ID == tokenID {
+			return token.Hash, token.Hash != ""
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// permissionsSystemListConfigModel is the configuration of list blocks scoped to a permission system
+type permissionsSystemListConfigModel struct {
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+}
+
+// permissionsSystemListConfigSchema returns the configuration schema of list blocks scoped to a permission system
+func permissionsSystemListConfigSchema(description string) listschema.Schema {
+	return listschema.Schema{
+		Description: description,
+		Attributes: map[string]listschema.Attribute{
+			"permission_system_id": listschema.StringAttribute{
+				Description: "The ID of the permission system",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// listResults streams a result for each item, up to the limit of the request. The fill function sets the
+// identity, display name and, when requested, the resource of each result.
+func listResults[T any](ctx context.Context, req list.ListRequest, items []T, fill func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			fill(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// stringValueOrNull returns a null value for empty strings, as the API omits unset fields
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/ps/ps-123/access/roles":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "arl-1", "name": "reader", "permissions": map[string]string{"authzed.v1/ReadSchema": ""}},
+				{"id": "arl-2", "name": "writer"},
+			}})
+		case "/ps/ps-123/access/policies":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "apc-1", "name": "ci", "principalID": "asa-1", "roleIDs": []string{"arl-1"}},
+			}})
+		case "/ps/ps-123/access/service-accounts":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "asa-1", "name": "ci"},
+			}})
+		case "/ps/ps-123/access/service-accounts/asa-1/tokens":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "atk-1", "name": "deploy", "hash": "abc"},
+			}})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	providerServer, schemaResp := testConfiguredProviderServer(t, server.URL)
+	listServer, ok := providerServer.(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		t.Fatal("Expected the provider server to support list resources")
+	}
+
+	testCases := []struct {
+		typeName  string
+		config    map[string]tftypes.Value
+		limit     int64
+		wantNames []string
+		wantIDs   []string
+	}{
+		{
+			typeName:  "authzed_role",
+			config:    map[string]tftypes.Value{"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123")},
+			wantNames: []string{"reader", "writer"},
+			wantIDs:   []string{"arl-1", "arl-2"},
+		},
+		{
+			typeName:  "authzed_role",
+			config:    map[string]tftypes.Value{"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123")},
+			limit:     1,
+			wantNames: []string{"reader"},
+			wantIDs:   []string{"arl-1"},
+		},
+		{
+			typeName:  "authzed_policy",
+			config:    map[string]tftypes.Value{"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123")},
+			wantNames: []string{"ci"},
+			wantIDs:   []string{"apc-1"},
+		},
+		{
+			typeName:  "authzed_service_account",
+			config:    map[string]tftypes.Value{"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123")},
+			wantNames: []string{"ci"},
+			wantIDs:   []string{"asa-1"},
+		},
+		{
+			typeName: "authzed_token",
+			config: map[string]tftypes.Value{
+				"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123"),
+				"service_account_id":   tftypes.NewValue(tftypes.String, "asa-1"),
+			},
+			wantNames: []string{"deploy"},
+			wantIDs:   []string{"atk-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.typeName, func(t *testing.T) {
+			listSchema := schemaResp.ListResourceSchemas[tc.typeName]
+			if listSchema == nil {
+				t.Fatalf("Expected a list resource schema for %s", tc.typeName)
+			}
+
+			stream, err := listServer.ListResource(ctx, &tfprotov6.ListResourceRequest{
+				TypeName:        tc.typeName,
+				Config:          testDynamicValue(t, listSchema, tc.config),
+				IncludeResource: true,
+				Limit:           tc.limit,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var names, ids []string
+			for result := range stream.Results {
+				if len(result.Diagnostics) > 0 {
+					for _, d := range result.Diagnostics {
+						t.Errorf("%s: %s", d.Summary, d.Detail)
+					}
+					continue
+				}
+				names = append(names, result.DisplayName)
+
+				state := testDecodeStrings(t, result.Resource, schemaResp.ResourceSchemas[tc.typeName].ValueType())
+				if state["permission_system_id"] != "ps-123" {
+					t.Errorf("Expected the permission system ID in the resource, got %v", state)
+				}
+				ids = append(ids, state["id"])
+			}
+
+			if len(names) != len(tc.wantNames) || len(ids) != len(tc.wantIDs) {
+				t.Fatalf("Expected %v %v, got %v %v", tc.wantNames, tc.wantIDs, names, ids)
+			}
+			for i := range names {
+				if names[i] != tc.wantNames[i] || ids[i] != tc.wantIDs[i] {
+					t.Errorf("Expected %v %v, got %v %v", tc.wantNames, tc.wantIDs, names, ids)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var _ datasource.DataSourceWithConfigure = &materializeClustersDataSource{}
+
+func NewMaterializeClustersDataSource() datasource.DataSource {
+	return &materializeClustersDataSource{}
+}
+
+type materializeClustersDataSource struct {
+	client *client.CloudClient
+}
+
+// materializeClustersDataSourceModel maps the data source schema to values
+type materializeClustersDataSourceModel struct {
+	ID                  types.String              `tfsdk:"id"`
+	PermissionsSystemID types.String              `tfsdk:"permission_system_id"`
+	DeploymentID        types.String              `tfsdk:"deployment_id"`
+	Clusters            []materializeClusterModel `tfsdk:"clusters"`
+}
+
+type materializeClusterModel struct {
+	ID                 types.String                `tfsdk:"id"`
+	Name               types.String                `tfsdk:"name"`
+	DeploymentID       types.String                `tfsdk:"deployment_id"`
+	WatchedPermissions types.List                  `tfsdk:"watched_permissions"`
+	DatabaseName       types.String                `tfsdk:"database_name"`
+	Region             types.String                `tfsdk:"region"`
+	Ready              types.Bool                  `tfsdk:"ready"`
+	Conditions         []materializeConditionModel `tfsdk:"conditions"`
+	LatestSnapshot     *materializeJobStatusModel  `tfsdk:"latest_snapshot"`
+	LatestGroupStore   *materializeJobStatusModel  `tfsdk:"latest_group_store"`
+}
+
+type materializeConditionModel struct {
+	Type               types.String `tfsdk:"type"`
+	Status             types.String `tfsdk:"status"`
+	Reason             types.String `tfsdk:"reason"`
+	Message            types.String `tfsdk:"message"`
+	ObservedGeneration types.Int64  `tfsdk:"observed_generation"`
+	LastTransitionTime types.String `tfsdk:"last_transition_time"`
+}
+
+type materializeJobStatusModel struct {
+	Tag            types.String `tfsdk:"tag"`
+	StartTime      types.String `tfsdk:"start_time"`
+	CompletionTime types.String `tfsdk:"completion_time"`
+}
+
+func (d *materializeClustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_materialize_clusters"
+}
+
+func (d *materializeClustersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	jobStatusAttributes := map[string]schema.Attribute{
+		"tag": schema.StringAttribute{
+			Computed:    true,
+			Description: "Tag of the job",
+		},
+		"start_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the job started",
+		},
+		"completion_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the job completed, empty if it is still running",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the Materialize clusters of a permission system",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for the data source",
+			},
+			"permission_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the permission system",
+			},
+			"deployment_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters of this deployment",
+			},
+			"clusters": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of Materialize clusters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the Materialize cluster",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Materialize deployment",
+						},
+						"deployment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the permission system deployment",
+						},
+						"watched_permissions": schema.ListAttribute{
+							Computed:    true,
+							Description: "Permissions the cluster watches for changes",
+							ElementType: types.StringType,
+						},
+						"database_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the database holding the materialized view",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region of the cluster",
+						},
+						"ready": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the cluster reports a Ready condition with status True",
+						},
+						"conditions": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Status conditions of the cluster",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:    true,
+										Description: "Type of the condition",
+									},
+									"status": schema.StringAttribute{
+										Computed:    true,
+										Description: "Status of the condition (True, False or Unknown)",
+									},
+									"reason": schema.StringAttribute{
+										Computed:    true,
+										Description: "Machine-readable reason for the condition",
+									},
+									"message": schema.StringAttribute{
+										Computed:    true,
+										Description: "Human-readable message for the condition",
+									},
+									"observed_generation": schema.Int64Attribute{
+										Computed:    true,
+										Description: "Generation observed when the condition was set",
+									},
+									"last_transition_time": schema.StringAttribute{
+										Computed:    true,
+										Description: "Timestamp of the last status transition",
+									},
+								},
+							},
+						},
+						"latest_snapshot": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Status of the latest snapshot job",
+							Attributes:  jobStatusAttributes,
+						},
+						"latest_group_store": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Status of the latest group store job",
+							Attributes:  jobStatusAttributes,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *materializeClustersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *materializeClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data materializeClustersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use permission system ID as the data source ID
+	data.ID = data.PermissionsSystemID
+
+	clusters, err := d.client.ListMaterializeClusters(ctx, data.PermissionsSystemID.ValueString(), data.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Materialize clusters, got error: %s", err))
+		return
+	}
+
+	clusterList := make([]materializeClusterModel, 0, len(clusters))
+	for _, cluster := range clusters {
+		clusterModel, diags := newMaterializeClusterModel(ctx, cluster)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clusterList = append(clusterList, clusterModel)
+	}
+	data.Clusters = clusterList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newMaterializeClusterModel maps an API Materialize cluster onto the data source model
+func newMaterializeClusterModel(ctx context.Context, cluster models.MaterializeCluster) (materializeClusterModel, diag.Diagnostics) {
+	watchedPermissions, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(cluster.WatchedPermissions))
+
+	conditions := make([]materializeConditionModel, 0, len(cluster.Status.Conditions))
+	for _, condition := range cluster.Status.Conditions {
+		conditions = append(conditions, materializeConditionModel{
+			Type:               types.StringValue(condition.Type),
+			Status:             types.StringValue(condition.Status),
+			Reason:             types.StringValue(condition.Reason),
+			Message:            types.StringValue(condition.Message),
+			ObservedGeneration: types.Int64Value(condition.ObservedGeneration),
+			LastTransitionTime: types.StringValue(condition.LastTransitionTime),
+		})
+	}
+
+	return materializeClusterModel{
+		ID:                 types.StringValue(cluster.ID),
+		Name:               types.StringValue(cluster.Name),
+		DeploymentID:       types.StringValue(cluster.DeploymentID),
+		WatchedPermissions: watchedPermissions,
+		DatabaseName:       types.StringValue(cluster.Status.DatabaseName),
+		Region:             types.StringValue(cluster.Status.Region),
+		Ready:              types.BoolValue(materializeClusterReady(cluster.Status.Conditions)),
+		Conditions:         conditions,
+		LatestSnapshot:     newMaterializeJobStatusModel(cluster.Status.LatestSnapshot),
+		LatestGroupStore:   newMaterializeJobStatusModel(cluster.Status.LatestGroupStore),
+	}, diags
+}
+
+func newMaterializeJobStatusModel(jobStatus *models.MaterializeClusterJobStatus) *materializeJobStatusModel {
+	if jobStatus == nil {
+		return nil
+	}
+	return &materializeJobStatusModel{
+		Tag:            types.StringValue(jobStatus.Tag),
+		StartTime:      types.StringValue(jobStatus.StartTime),
+		CompletionTime: types.StringValue(jobStatus.CompletionTime),
+	}
+}
+
+// materializeClusterReady reports whether the conditions contain a Ready condition with status True
+func materializeClusterReady(conditions []models.MaterializeClusterStatusCondition) bool {
+	for _, condition := range conditions {
+		if strings.EqualFold(condition.Type, "Ready") {
+			return strings.EqualFold(condition.Status, "True")
+		}
+	}
+	return false
+}
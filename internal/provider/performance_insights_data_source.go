@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var _ datasource.DataSourceWithConfigure = &performanceInsightsDataSource{}
+
+func NewPerformanceInsightsDataSource() datasource.DataSource {
+	return &performanceInsightsDataSource{}
+}
+
+type performanceInsightsDataSource struct {
+	client *client.CloudClient
+}
+
+// performanceInsightsDataSourceModel maps the data source schema to values
+type performanceInsightsDataSourceModel struct {
+	ID                  types.String           `tfsdk:"id"`
+	PermissionsSystemID types.String           `tfsdk:"permission_system_id"`
+	Period              types.String           `tfsdk:"period"`
+	TopK                types.Int64            `tfsdk:"top_k"`
+	SortBy              types.String           `tfsdk:"sort_by"`
+	Shapes              []apiShapeMetricsModel `tfsdk:"shapes"`
+}
+
+type apiShapeMetricsModel struct {
+	ShapeID            types.String  `tfsdk:"shape_id"`
+	APIName            types.String  `tfsdk:"api_name"`
+	APICategory        types.String  `tfsdk:"api_category"`
+	APIIndex           types.Int64   `tfsdk:"api_index"`
+	Count              types.Int64   `tfsdk:"count"`
+	ShapeFields        types.Map     `tfsdk:"shape_fields"`
+	OrderedShapeFields types.List    `tfsdk:"ordered_shape_fields"`
+	P50LatencySeconds  types.Float64 `tfsdk:"p50_latency_seconds"`
+	P95LatencySeconds  types.Float64 `tfsdk:"p95_latency_seconds"`
+	P99LatencySeconds  types.Float64 `tfsdk:"p99_latency_seconds"`
+}
+
+func (d *performanceInsightsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_performance_insights"
+}
+
+func (d *performanceInsightsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the top K most expensive permissions API calls of a permission system",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for the data source",
+			},
+			"permission_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the permission system",
+			},
+			"period": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time period to analyze (1m, 5m, 10m, 30m, 1h, 6h, 12h or 24h). Defaults to 1h",
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.PerformanceInsightsPeriods...),
+				},
+			},
+			"top_k": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of API call shapes to return, between 1 and 50. Defaults to 10",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"sort_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "Field to sort the results by (impact, count, p50, p95 or p99). Defaults to impact, which is P50 latency multiplied by count",
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.PerformanceInsightsSortBy...),
+				},
+			},
+			"shapes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Most expensive API call shapes, in sort order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"shape_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the API shape",
+						},
+						"api_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the API call (e.g., CheckPermission, LookupResources)",
+						},
+						"api_category": schema.StringAttribute{
+							Computed:    true,
+							Description: "Category of the API call (check, lookup or write)",
+						},
+						"api_index": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Index of the API call type, used for categorization and sorting",
+						},
+						"count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of times this API shape was called over the period",
+						},
+						"shape_fields": schema.MapAttribute{
+							Computed:    true,
+							Description: "Fields of the API shape (e.g., resource_type, subject_type)",
+							ElementType: types.StringType,
+						},
+						"ordered_shape_fields": schema.ListAttribute{
+							Computed:    true,
+							Description: "Names of the shape fields in display order",
+							ElementType: types.StringType,
+						},
+						"p50_latency_seconds": schema.Float64Attribute{
+							Computed:    true,
+							Description: "50th percentile latency over the period, in seconds",
+						},
+						"p95_latency_seconds": schema.Float64Attribute{
+							Computed:    true,
+							Description: "95th percentile latency over the period, in seconds",
+						},
+						"p99_latency_seconds": schema.Float64Attribute{
+							Computed:    true,
+							Description: "99th percentile latency over the period, in seconds",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *performanceInsightsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *performanceInsightsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data performanceInsightsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use permission system ID as the data source ID
+	data.ID = data.PermissionsSystemID
+
+	shapes, err := d.client.GetPerformanceInsightsTopK(
+		ctx,
+		data.PermissionsSystemID.ValueString(),
+		data.Period.ValueString(),
+		data.TopK.ValueInt64(),
+		data.SortBy.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read performance insights, got error: %s", err))
+		return
+	}
+
+	shapeList := make([]apiShapeMetricsModel, 0, len(shapes))
+	for _, shape := range shapes {
+		shapeModel, diags := newAPIShapeMetricsModel(ctx, shape)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		shapeList = append(shapeList, shapeModel)
+	}
+	data.Shapes = shapeList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newAPIShapeMetricsModel maps API shape metrics onto the data source model.
+// Metrics missing from the response are null.
+func newAPIShapeMetricsModel(ctx context.Context, shape models.APIShapeMetrics) (apiShapeMetricsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	shapeFields := shape.ShapeFields
+	if shapeFields == nil {
+		shapeFields = map[string]string{}
+	}
+	shapeFieldsValue, mapDiags := types.MapValueFrom(ctx, types.StringType, shapeFields)
+	diags.Append(mapDiags...)
+
+	orderedShapeFields, listDiags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(shape.OrderedShapeFields))
+	diags.Append(listDiags...)
+
+	return apiShapeMetricsModel{
+		ShapeID:            types.StringValue(shape.ShapeID),
+		APIName:            types.StringValue(shape.APIName),
+		APICategory:        types.StringValue(shape.APICategory),
+		APIIndex:           types.Int64Value(shape.APIIndex),
+		Count:              types.Int64PointerValue(shape.Count),
+		ShapeFields:        shapeFieldsValue,
+		OrderedShapeFields: orderedShapeFields,
+		P50LatencySeconds:  types.Float64PointerValue(shape.P50LatencySeconds),
+		P95LatencySeconds:  types.Float64PointerValue(shape.P95LatencySeconds),
+		P99LatencySeconds:  types.Float64PointerValue(shape.P99LatencySeconds),
+	}, diags
+}
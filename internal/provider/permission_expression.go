@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"cel.dev/cel-go/cel"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+var (
+	permissionExpressionEnvOnce sync.Once
+	permissionExpressionEnvErr  error
+	permissionExpressionEnvVal  *cel.Env
+)
+
+// permissionExpressionEnv returns the CEL environment role permission expressions are checked with.
+// The Cloud API exposes the request of each API method as a variable named after its message,
+// e.g. CheckPermissionRequest for authzed.v1/CheckPermission. The request messages are declared
+// as dynamic values, so field names are not checked.
+func permissionExpressionEnv() (*cel.Env, error) {
+	permissionExpressionEnvOnce.Do(func() {
+		options := make([]cel.EnvOption, 0, len(models.PermissionExprMapKeys))
+		for _, variable := range permissionExpressionVariables() {
+			options = append(options, cel.Variable(variable, cel.DynType))
+		}
+		permissionExpressionEnvVal, permissionExpressionEnvErr = cel.NewEnv(options...)
+	})
+	return permissionExpressionEnvVal, permissionExpressionEnvErr
+}
+
+// permissionExpressionVariables returns the sorted names of the request variables available to expressions
+func permissionExpressionVariables() []string {
+	variables := make([]string, 0, len(models.PermissionExprMapKeys))
+	for _, key := range models.PermissionExprMapKeys {
+		variables = append(variables, apiMethodName(key)+"Request")
+	}
+	sort.Strings(variables)
+	return variables
+}
+
+// checkPermissionExpression parses and type-checks a role permission expression. An empty expression
+// grants the permission unconditionally and is always valid. The returned error lists every syntax and
+// type error with its line and column.
+func checkPermissionExpression(expression string) error {
+	if strings.TrimSpace(expression) == "" {
+		return nil
+	}
+
+	env, err := permissionExpressionEnv()
+	if err != nil {
+		return fmt.Errorf("unable to create CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return fmt.Errorf("%s", issues.String())
+	}
+
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return fmt.Errorf("expression must evaluate to a bool, got %s", outputType)
+	}
+
+	return nil
+}
+
+var _ validator.Map = permissionExpressionsValidator{}
+
+// permissionExpressionsValidator validates that every value of a role permissions map is a valid expression
+type permissionExpressionsValidator struct{}
+
+// validPermissionExpressions returns a validator for the values of a role permissions map
+func validPermissionExpressions() validator.Map {
+	return permissionExpressionsValidator{}
+}
+
+func (v permissionExpressionsValidator) Description(_ context.Context) string {
+	return "values must be empty or valid CEL expressions that evaluate to a bool"
+}
+
+func (v permissionExpressionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v permissionExpressionsValidator) ValidateMap(_ context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	// Report errors in a stable order
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, ok := elements[key].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if err := checkPermissionExpression(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Permission Expression",
+				fmt.Sprintf("The expression for %q is not valid:\n\n%s", key, err),
+			)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckPermissionExpression(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{name: "Empty", expression: ""},
+		{name: "Comparison", expression: `CheckPermissionRequest.permission == "admin"`},
+		{name: "Macro", expression: `WriteRelationshipsRequest.updates.all(u, u.relationship.resource.object_type == "document")`},
+		{name: "SyntaxError", expression: `CheckPermissionRequest.permission ==`, wantErr: "1:37"},
+		{name: "UndeclaredVariable", expression: `CheckRequest.permission == "admin"`, wantErr: "undeclared reference to 'CheckRequest'"},
+		{name: "TypeError", expression: `"admin" + 1 == "admin1"`, wantErr: "no matching overload"},
+		{name: "NotBool", expression: `"admin"`, wantErr: "must evaluate to a bool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkPermissionExpression(tc.expression)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPermissionExpressionsValidator(t *testing.T) {
+	resp := &validator.MapResponse{}
+	validPermissionExpressions().ValidateMap(context.Background(), validator.MapRequest{
+		Path: path.Root("permissions"),
+		ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"authzed.v1/ReadSchema":      types.StringValue(""),
+			"authzed.v1/CheckPermission": types.StringValue(`CheckPermissionRequest.permission ==`),
+			"authzed.v1/LookupResources": types.StringUnknown(),
+		}),
+	}, resp)
+
+	if len(resp.Diagnostics.Errors()) != 1 {
+		t.Fatalf("Expected 1 error, got %v", resp.Diagnostics)
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "authzed.v1/CheckPermission") {
+		t.Errorf("Expected the permission key in the error, got %q", resp.Diagnostics.Errors()[0].Detail())
+	}
+}
+
+func TestValidatePermissionExpressionFunction(t *testing.T) {
+	ctx := context.Background()
+	f := NewValidatePermissionExpressionFunction()
+
+	run := func(expression string) *function.RunResponse {
+		resp := &function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+		f.Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(expression)}),
+		}, resp)
+		return resp
+	}
+
+	resp := run(`CheckPermissionRequest.permission == "admin"`)
+	if resp.Error != nil {
+		t.Fatalf("Unexpected error: %v", resp.Error)
+	}
+	if !resp.Result.Value().Equal(types.BoolValue(true)) {
+		t.Errorf("Expected true, got %v", resp.Result.Value())
+	}
+
+	resp = run(`CheckPermissionRequest.permission == `)
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Fatalf("Expected an error on the expression argument, got %v", resp.Error)
+	}
+	if !strings.Contains(resp.Error.Text, "Syntax error") {
+		t.Errorf("Expected a syntax error, got %q", resp.Error.Text)
+	}
+}
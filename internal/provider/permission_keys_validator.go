@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+var _ validator.Map = permissionKeysValidator{}
+
+// permissionKeysValidator validates that every key of a role permissions map is an API method
+// listed in the PermissionExprMap schema of the OpenAPI spec
+type permissionKeysValidator struct{}
+
+// validPermissionKeys returns a validator for the keys of a role permissions map
+func validPermissionKeys() validator.Map {
+	return permissionKeysValidator{}
+}
+
+func (v permissionKeysValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("keys must be one of: %s", strings.Join(models.PermissionExprMapKeys, ", "))
+}
+
+func (v permissionKeysValidator) MarkdownDescription(_ context.Context) string {
+	keys := make([]string, 0, len(models.PermissionExprMapKeys))
+	for _, key := range models.PermissionExprMapKeys {
+		keys = append(keys, fmt.Sprintf("`%s`", key))
+	}
+	return fmt.Sprintf("keys must be one of: %s", strings.Join(keys, ", "))
+}
+
+func (v permissionKeysValidator) ValidateMap(_ context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	keys := make([]string, 0, len(req.ConfigValue.Elements()))
+	for key := range req.ConfigValue.Elements() {
+		keys = append(keys, key)
+	}
+	// Report errors in a stable order
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if isPermissionKey(key) {
+			continue
+		}
+
+		detail := fmt.Sprintf("%q is not a known permission.", key)
+		if suggestion := suggestPermissionKey(key); suggestion != "" {
+			detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+		}
+		detail += fmt.Sprintf("\n\nValid permissions are: %s", strings.Join(models.PermissionExprMapKeys, ", "))
+
+		resp.Diagnostics.AddAttributeError(req.Path.AtMapKey(key), "Invalid Permission", detail)
+	}
+}
+
+// isPermissionKey reports whether key is a permission listed in the OpenAPI spec
+func isPermissionKey(key string) bool {
+	for _, known := range models.PermissionExprMapKeys {
+		if key == known {
+			return true
+		}
+	}
+	return false
+}
+
+// permissionKeyPrefix is the prefix shared by all permission keys
+const permissionKeyPrefix = "authzed.v1/"
+
+// suggestPermissionKey returns the known permission closest to key, or an empty string if none is close enough.
+// Keys missing the authzed.v1/ prefix or differing only in case are matched as well.
+func suggestPermissionKey(key string) string {
+	candidate := key
+	if !strings.Contains(candidate, "/") {
+		candidate = permissionKeyPrefix + candidate
+	}
+	candidate = strings.ToLower(candidate)
+
+	best := ""
+	bestDistance := -1
+	for _, known := range models.PermissionExprMapKeys {
+		distance := levenshteinDistance(candidate, strings.ToLower(known))
+		if bestDistance == -1 || distance < bestDistance {
+			best = known
+			bestDistance = distance
+		}
+	}
+
+	// Only suggest keys within a few edits of the method name
+	maxDistance := len(strings.TrimPrefix(best, permissionKeyPrefix)) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	if bestDistance > maxDistance {
+		return ""
+	}
+	return best
+}
+
+// levenshteinDistance returns the number of single character edits needed to turn a into b
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
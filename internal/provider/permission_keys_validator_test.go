@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPermissionKeysValidator(t *testing.T) {
+	ctx := context.Background()
+
+	permissions := func(keys ...string) types.Map {
+		elements := make(map[string]attr.Value, len(keys))
+		for _, key := range keys {
+			elements[key] = types.StringValue("")
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	testCases := []struct {
+		name        string
+		value       types.Map
+		wantErrors  int
+		wantSuggest string
+	}{
+		{name: "Null", value: types.MapNull(types.StringType)},
+		{name: "Unknown", value: types.MapUnknown(types.StringType)},
+		{name: "Valid", value: permissions("authzed.v1/CheckPermission", "authzed.v1/WriteSchema")},
+		{name: "Typo", value: permissions("authzed.v1/CheckPermisson"), wantErrors: 1, wantSuggest: "authzed.v1/CheckPermission"},
+		{name: "MissingPrefix", value: permissions("ReadSchema"), wantErrors: 1, wantSuggest: "authzed.v1/ReadSchema"},
+		{name: "WrongCase", value: permissions("authzed.v1/lookupresources"), wantErrors: 1, wantSuggest: "authzed.v1/LookupResources"},
+		{name: "NoSuggestion", value: permissions("document:read"), wantErrors: 1},
+		{name: "Multiple", value: permissions("authzed.v1/Watch", "authzed.v1/Wacth", "authzed.v1/ReadSchemas"), wantErrors: 2, wantSuggest: "authzed.v1/ReadSchema"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.MapRequest{
+				Path:        path.Root("permissions"),
+				ConfigValue: tc.value,
+			}
+			resp := &validator.MapResponse{}
+			validPermissionKeys().ValidateMap(ctx, req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != tc.wantErrors {
+				t.Fatalf("Expected %d errors, got %v", tc.wantErrors, resp.Diagnostics)
+			}
+			if tc.wantErrors == 0 {
+				return
+			}
+
+			detail := errs[0].Detail()
+			if tc.wantSuggest != "" && !strings.Contains(detail, `Did you mean "`+tc.wantSuggest+`"?`) {
+				t.Errorf("Expected suggestion %q, got %q", tc.wantSuggest, detail)
+			}
+			if tc.wantSuggest == "" && strings.Contains(detail, "Did you mean") {
+				t.Errorf("Expected no suggestion, got %q", detail)
+			}
+		})
+	}
+}
+
+func TestPermissionKeysValidatorErrorPath(t *testing.T) {
+	resp := &validator.MapResponse{}
+	validPermissionKeys().ValidateMap(context.Background(), validator.MapRequest{
+		Path:        path.Root("permissions"),
+		ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"authzed.v1/CheckPermisson": types.StringValue("")}),
+	}, resp)
+
+	withPath, ok := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path })
+	if !ok {
+		t.Fatalf("Expected an attribute diagnostic, got %T", resp.Diagnostics.Errors()[0])
+	}
+	if want := path.Root("permissions").AtMapKey("authzed.v1/CheckPermisson"); !withPath.Path().Equal(want) {
+		t.Errorf("Expected path %s, got %s", want, withPath.Path())
+	}
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var (
+	_ resource.Resource                = &permissionsSystemAuditLogResource{}
+	_ resource.ResourceWithImportState = &permissionsSystemAuditLogResource{}
+)
+
+func NewPermissionsSystemAuditLogResource() resource.Resource {
+	return &permissionsSystemAuditLogResource{}
+}
+
+type permissionsSystemAuditLogResource struct {
+	client *client.CloudClient
+}
+
+type permissionsSystemAuditLogResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	TargetType          types.String `tfsdk:"target_type"`
+	StreamName          types.String `tfsdk:"stream_name"`
+	TargetEndpointURL   types.String `tfsdk:"target_endpoint_url"`
+	TargetConfiguration types.Map    `tfsdk:"target_configuration"`
+	DisabledOnMethods   types.List   `tfsdk:"disabled_on_methods"`
+	DisplayName         types.String `tfsdk:"display_name"`
+}
+
+func (r *permissionsSystemAuditLogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_system_audit_log"
+}
+
+func (r *permissionsSystemAuditLogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the audit log feature of a permission system",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for this resource, equal to the permission system ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the permission system whose audit log is managed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the audit log feature is enabled. Defaults to true",
+			},
+			"target_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the destination sink (aws-kinesis, aws-firehose, aws-s3 or gcp-cloud-storage)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.AuditLogTargetTypes...),
+				},
+			},
+			"stream_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the destination sink",
+			},
+			"target_endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Endpoint URL of the destination sink",
+			},
+			"target_configuration": schema.MapAttribute{
+				Optional:    true,
+				Description: "Target-specific settings for the destination sink",
+				ElementType: types.StringType,
+			},
+			"disabled_on_methods": schema.ListAttribute{
+				Optional:    true,
+				Description: "Methods that will not be logged. If empty, all methods are logged",
+				ElementType: types.StringType,
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Display name of the feature",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionsSystemAuditLogResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *permissionsSystemAuditLogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data permissionsSystemAuditLogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemAuditLogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data permissionsSystemAuditLogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, err := r.client.GetAuditLogFeature(ctx, data.PermissionsSystemID.ValueString())
+	if err != nil {
+		apiErr := &client.APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// Permission system was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit log feature, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setAuditLogResourceData(ctx, &data, feature)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemAuditLogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data permissionsSystemAuditLogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.set(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete disables the audit log feature, the feature itself cannot be removed
+func (r *permissionsSystemAuditLogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data permissionsSystemAuditLogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := buildAuditLogFeatureConfig(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &models.SetAuditLogFeatureRequest{
+		Config:  config,
+		Enabled: false,
+	}
+
+	if _, err := r.client.SetAuditLogFeature(ctx, data.PermissionsSystemID.ValueString(), request); err != nil {
+		apiErr := &client.APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// Permission system is already gone
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable audit log feature, got error: %s", err))
+		return
+	}
+}
+
+// ImportState imports the audit log feature using the permission system ID
+func (r *permissionsSystemAuditLogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), req.ID)...)
+}
+
+// set sends the planned configuration to the API and refreshes the model from the response
+func (r *permissionsSystemAuditLogResource) set(ctx context.Context, data *permissionsSystemAuditLogResourceModel, diags *diag.Diagnostics) {
+	config, configDiags := buildAuditLogFeatureConfig(ctx, data)
+	diags.Append(configDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	request := &models.SetAuditLogFeatureRequest{
+		Config:  config,
+		Enabled: data.Enabled.ValueBool(),
+	}
+
+	feature, err := r.client.SetAuditLogFeature(ctx, data.PermissionsSystemID.ValueString(), request)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to configure audit log feature, got error: %s", err))
+		return
+	}
+
+	diags.Append(setAuditLogResourceData(ctx, data, feature)...)
+}
+
+// buildAuditLogFeatureConfig converts the resource model into the API feature configuration
+func buildAuditLogFeatureConfig(ctx context.Context, data *permissionsSystemAuditLogResourceModel) (*models.AuditLogFeatureConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := &models.AuditLogFeatureConfig{
+		TargetType:        data.TargetType.ValueString(),
+		StreamName:        data.StreamName.ValueString(),
+		TargetEndpointURL: data.TargetEndpointURL.ValueString(),
+	}
+
+	if !data.TargetConfiguration.IsNull() && !data.TargetConfiguration.IsUnknown() {
+		targetConfiguration := make(map[string]string)
+		diags.Append(data.TargetConfiguration.ElementsAs(ctx, &targetConfiguration, false)...)
+		config.TargetConfiguration = targetConfiguration
+	}
+
+	if !data.DisabledOnMethods.IsNull() && !data.DisabledOnMethods.IsUnknown() {
+		var disabledOnMethods []string
+		diags.Append(data.DisabledOnMethods.ElementsAs(ctx, &disabledOnMethods, false)...)
+		config.DisabledOnMethods = disabledOnMethods
+	}
+
+	return config, diags
+}
+
+// setAuditLogResourceData maps the API feature onto the resource model. Optional
+// values the API returns empty are kept null so that unset arguments don't drift,
+// unless the plan or state already holds an empty collection.
+func setAuditLogResourceData(ctx context.Context, data *permissionsSystemAuditLogResourceModel, feature *models.AuditLogFeature) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = data.PermissionsSystemID
+	data.Enabled = types.BoolValue(feature.Enabled)
+	data.DisplayName = types.StringValue(feature.DisplayName)
+
+	config := feature.Config
+	if config == nil {
+		data.TargetType = types.StringNull()
+		data.StreamName = types.StringNull()
+		config = &models.AuditLogFeatureConfig{}
+	} else {
+		data.TargetType = types.StringValue(config.TargetType)
+		data.StreamName = types.StringValue(config.StreamName)
+	}
+
+	if config.TargetEndpointURL != "" {
+		data.TargetEndpointURL = types.StringValue(config.TargetEndpointURL)
+	} else {
+		data.TargetEndpointURL = types.StringNull()
+	}
+
+	switch {
+	case len(config.TargetConfiguration) > 0:
+		targetConfiguration, mapDiags := types.MapValueFrom(ctx, types.StringType, config.TargetConfiguration)
+		diags.Append(mapDiags...)
+		data.TargetConfiguration = targetConfiguration
+	case !data.TargetConfiguration.IsNull() && !data.TargetConfiguration.IsUnknown() && len(data.TargetConfiguration.Elements()) == 0:
+		// Keep the configured empty map
+	default:
+		data.TargetConfiguration = types.MapNull(types.StringType)
+	}
+
+	switch {
+	case len(config.DisabledOnMethods) > 0:
+		disabledOnMethods, listDiags := types.ListValueFrom(ctx, types.StringType, config.DisabledOnMethods)
+		diags.Append(listDiags...)
+		data.DisabledOnMethods = disabledOnMethods
+	case !data.DisabledOnMethods.IsNull() && !data.DisabledOnMethods.IsUnknown() && len(data.DisabledOnMethods.Elements()) == 0:
+		// Keep the configured empty list
+	default:
+		data.DisabledOnMethods = types.ListNull(types.StringType)
+	}
+
+	return diags
+}
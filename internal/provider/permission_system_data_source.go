@@ -7,9 +7,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
 )
 
 var _ datasource.DataSourceWithConfigure = &permissionsSystemDataSource{}
@@ -29,6 +31,9 @@ type permissionsSystemDataSourceModel struct {
 	SystemType    types.String `tfsdk:"system_type"`
 	SystemState   types.Object `tfsdk:"system_state"`
 	Version       types.Object `tfsdk:"version"`
+	Capabilities  types.List   `tfsdk:"capabilities"`
+	Features      types.List   `tfsdk:"features"`
+	Datastore     types.Object `tfsdk:"datastore"`
 }
 
 func (d *permissionsSystemDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -72,44 +77,25 @@ func (d *permissionsSystemDataSource) Schema(_ context.Context, _ datasource.Sch
 			"version": schema.SingleNestedAttribute{
 				Computed:    true,
 				Description: "Version information for the permission system",
-				Attributes: map[string]schema.Attribute{
-					"current_version": schema.SingleNestedAttribute{
-						Computed:    true,
-						Description: "Current SpiceDB version",
-						Attributes: map[string]schema.Attribute{
-							"display_name": schema.StringAttribute{
-								Computed:    true,
-								Description: "Display name of the version",
-							},
-							"supported_feature_names": schema.ListAttribute{
-								Computed:    true,
-								Description: "Features supported by this version",
-								ElementType: types.StringType,
-							},
-							"version": schema.StringAttribute{
-								Computed:    true,
-								Description: "Version of SpiceDB",
-							},
-						},
-					},
-					"has_update_available": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether an update is available",
-					},
-					"is_locked_to_version": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether the version is locked",
-					},
-					"override_image": schema.StringAttribute{
-						Computed:    true,
-						Description: "Override image for SpiceDB",
-					},
-					"selected_channel": schema.StringAttribute{
-						Computed:    true,
-						Description: "Selected channel for updates",
-					},
+				Attributes:  permissionsSystemVersionAttributes(),
+			},
+			"capabilities": schema.ListAttribute{
+				Computed:    true,
+				Description: "Capabilities of the permission system (e.g., role_management, version_pinning)",
+				ElementType: types.StringType,
+			},
+			"features": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Features of the permission system and whether they are enabled",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: permissionsSystemFeatureAttributes(),
 				},
 			},
+			"datastore": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Datastore backing the permission system",
+				Attributes:  permissionsSystemDatastoreAttributes(),
+			},
 		},
 	}
 }
@@ -167,58 +153,212 @@ func (d *permissionsSystemDataSource) Read(ctx context.Context, req datasource.R
 	}
 	data.SystemState = systemStateObj
 
-	supportedFeatureNames := []attr.Value{}
-	for _, feature := range permissionsSystem.Version.CurrentVersion.SupportedFeatureNames {
-		supportedFeatureNames = append(supportedFeatureNames, types.StringValue(feature))
+	details, diags := newPermissionsSystemDetails(ctx, permissionsSystem)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.Version = details.Version
+	data.Capabilities = details.Capabilities
+	data.Features = details.Features
+	data.Datastore = details.Datastore
 
-	supportedFeaturesList, diags := types.ListValue(
-		types.StringType,
-		supportedFeatureNames,
-	)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-	currentVersionMap := map[string]attr.Value{
-		"display_name":            types.StringValue(permissionsSystem.Version.CurrentVersion.DisplayName),
-		"supported_feature_names": supportedFeaturesList,
-		"version":                 types.StringValue(permissionsSystem.Version.CurrentVersion.Version),
+// permissionsSystemVersionAttributes returns the schema attributes describing the version settings of a permission system
+func permissionsSystemVersionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"current_version": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Current SpiceDB version",
+			Attributes:  spiceDBVersionAttributes(),
+		},
+		"available_versions": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "SpiceDB versions the permission system can use. Only populated when the system is on an update channel",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: spiceDBVersionAttributes(),
+			},
+		},
+		"has_update_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether an update is available",
+		},
+		"is_locked_to_version": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the version is locked",
+		},
+		"override_image": schema.StringAttribute{
+			Computed:    true,
+			Description: "Override image for SpiceDB",
+		},
+		"selected_channel": schema.StringAttribute{
+			Computed:    true,
+			Description: "Selected channel for updates",
+		},
+		"selected_channel_display_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name of the selected channel",
+		},
 	}
+}
 
-	currentVersionObj, diags := types.ObjectValue(
-		map[string]attr.Type{
-			"display_name":            types.StringType,
-			"supported_feature_names": types.ListType{ElemType: types.StringType},
-			"version":                 types.StringType,
+// permissionsSystemFeatureAttributes returns the schema attributes describing a permission system feature
+func permissionsSystemFeatureAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the feature (e.g., AuditLog, DatadogExport)",
 		},
-		currentVersionMap,
-	)
-	resp.Diagnostics.Append(diags...)
+		"display_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name of the feature",
+		},
+		"enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the feature is enabled",
+		},
+	}
+}
 
-	// Map full Version object
-	versionMap := map[string]attr.Value{
-		"current_version":      currentVersionObj,
-		"has_update_available": types.BoolValue(permissionsSystem.Version.HasUpdateAvailable),
-		"is_locked_to_version": types.BoolValue(permissionsSystem.Version.IsLockedToVersion),
-		"override_image":       types.StringValue(permissionsSystem.Version.OverrideImage),
-		"selected_channel":     types.StringValue(permissionsSystem.Version.SelectedChannel),
+// permissionsSystemDatastoreAttributes returns the schema attributes describing the datastore of a permission system
+func permissionsSystemDatastoreAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the datastore",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the datastore (cockroachdb, postgres or spanner)",
+		},
+		"region": schema.StringAttribute{
+			Computed:    true,
+			Description: "Region of the datastore",
+		},
+		"identifier": schema.StringAttribute{
+			Computed:    true,
+			Description: "Identifier of the datastore",
+		},
 	}
+}
 
-	versionObj, diags := types.ObjectValue(
-		map[string]attr.Type{
-			"current_version": types.ObjectType{AttrTypes: map[string]attr.Type{
-				"display_name":            types.StringType,
-				"supported_feature_names": types.ListType{ElemType: types.StringType},
-				"version":                 types.StringType,
-			}},
-			"has_update_available": types.BoolType,
-			"is_locked_to_version": types.BoolType,
-			"override_image":       types.StringType,
-			"selected_channel":     types.StringType,
-		},
-		versionMap,
-	)
-	resp.Diagnostics.Append(diags...)
-	data.Version = versionObj
+var (
+	spiceDBVersionAttrTypes = map[string]attr.Type{
+		"version":                 types.StringType,
+		"display_name":            types.StringType,
+		"supported_apis":          types.ListType{ElemType: types.StringType},
+		"supported_feature_names": types.ListType{ElemType: types.StringType},
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	permissionsSystemVersionAttrTypes = map[string]attr.Type{
+		"current_version":               types.ObjectType{AttrTypes: spiceDBVersionAttrTypes},
+		"available_versions":            types.ListType{ElemType: types.ObjectType{AttrTypes: spiceDBVersionAttrTypes}},
+		"has_update_available":          types.BoolType,
+		"is_locked_to_version":          types.BoolType,
+		"override_image":                types.StringType,
+		"selected_channel":              types.StringType,
+		"selected_channel_display_name": types.StringType,
+	}
+
+	permissionsSystemFeatureAttrTypes = map[string]attr.Type{
+		"id":           types.StringType,
+		"display_name": types.StringType,
+		"enabled":      types.BoolType,
+	}
+
+	permissionsSystemDatastoreAttrTypes = map[string]attr.Type{
+		"id":         types.StringType,
+		"type":       types.StringType,
+		"region":     types.StringType,
+		"identifier": types.StringType,
+	}
+)
+
+type permissionsSystemFeatureModel struct {
+	ID          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+type permissionsSystemDatastoreModel struct {
+	ID         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	Region     types.String `tfsdk:"region"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+// permissionsSystemDetails holds the version, capabilities, features and datastore of a permission system,
+// shared by the permission system data sources
+type permissionsSystemDetails struct {
+	Version      types.Object
+	Capabilities types.List
+	Features     types.List
+	Datastore    types.Object
+}
+
+// newPermissionsSystemDetails maps the details of an API permission system onto Terraform values.
+// The datastore is null when the API does not report one.
+func newPermissionsSystemDetails(ctx context.Context, permissionsSystem *models.PermissionsSystem) (permissionsSystemDetails, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	details := permissionsSystemDetails{
+		Datastore: types.ObjectNull(permissionsSystemDatastoreAttrTypes),
+	}
+
+	currentVersion, versionDiags := newSpiceDBVersionModel(ctx, permissionsSystem.Version.CurrentVersion)
+	diags.Append(versionDiags...)
+
+	availableVersions := make([]spiceDBVersionModel, 0, len(permissionsSystem.AvailableVersions))
+	for _, version := range permissionsSystem.AvailableVersions {
+		versionModel, versionDiags := newSpiceDBVersionModel(ctx, version)
+		diags.Append(versionDiags...)
+		availableVersions = append(availableVersions, versionModel)
+	}
+	if diags.HasError() {
+		return details, diags
+	}
+
+	currentVersionObj, objDiags := types.ObjectValueFrom(ctx, spiceDBVersionAttrTypes, currentVersion)
+	diags.Append(objDiags...)
+
+	availableVersionsList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: spiceDBVersionAttrTypes}, availableVersions)
+	diags.Append(listDiags...)
+
+	details.Version, objDiags = types.ObjectValue(permissionsSystemVersionAttrTypes, map[string]attr.Value{
+		"current_version":               currentVersionObj,
+		"available_versions":            availableVersionsList,
+		"has_update_available":          types.BoolValue(permissionsSystem.Version.HasUpdateAvailable),
+		"is_locked_to_version":          types.BoolValue(permissionsSystem.Version.IsLockedToVersion),
+		"override_image":                types.StringValue(permissionsSystem.Version.OverrideImage),
+		"selected_channel":              types.StringValue(permissionsSystem.Version.SelectedChannel),
+		"selected_channel_display_name": types.StringValue(permissionsSystem.Version.SelectedChannelDisplayName),
+	})
+	diags.Append(objDiags...)
+
+	details.Capabilities, listDiags = types.ListValueFrom(ctx, types.StringType, nonNilStrings(permissionsSystem.Capabilities))
+	diags.Append(listDiags...)
+
+	features := make([]permissionsSystemFeatureModel, 0, len(permissionsSystem.Features))
+	for _, feature := range permissionsSystem.Features {
+		features = append(features, permissionsSystemFeatureModel{
+			ID:          types.StringValue(feature.ID),
+			DisplayName: types.StringValue(feature.DisplayName),
+			Enabled:     types.BoolValue(feature.Enabled),
+		})
+	}
+	details.Features, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: permissionsSystemFeatureAttrTypes}, features)
+	diags.Append(listDiags...)
+
+	if datastore := permissionsSystem.Datastore; datastore != nil {
+		details.Datastore, objDiags = types.ObjectValueFrom(ctx, permissionsSystemDatastoreAttrTypes, permissionsSystemDatastoreModel{
+			ID:         types.StringValue(datastore.ID),
+			Type:       types.StringValue(datastore.Type),
+			Region:     types.StringValue(datastore.Region),
+			Identifier: types.StringValue(datastore.Identifier),
+		})
+		diags.Append(objDiags...)
+	}
+
+	return details, diags
 }
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var (
+	_ resource.Resource                = &permissionsSystemDatadogExportResource{}
+	_ resource.ResourceWithImportState = &permissionsSystemDatadogExportResource{}
+)
+
+func NewPermissionsSystemDatadogExportResource() resource.Resource {
+	return &permissionsSystemDatadogExportResource{}
+}
+
+type permissionsSystemDatadogExportResource struct {
+	client *client.CloudClient
+}
+
+type permissionsSystemDatadogExportResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	Site                types.String `tfsdk:"site"`
+	APIKey              types.String `tfsdk:"api_key"`
+	APIKeyVersion       types.String `tfsdk:"api_key_version"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	DisplayName         types.String `tfsdk:"display_name"`
+}
+
+func (r *permissionsSystemDatadogExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_system_datadog_export"
+}
+
+func (r *permissionsSystemDatadogExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Datadog metric export feature of a permission system",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for this resource, equal to the permission system ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the permission system whose metrics are exported",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"site": schema.StringAttribute{
+				Required:    true,
+				Description: "Datadog site associated with the API key (e.g., datadoghq.com)",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Required:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Description: "Datadog API key. This value is write-only and never stored in state; change api_key_version to send a new key",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"api_key_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that triggers the API key to be sent again when changed",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Datadog export feature is enabled",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Display name of the feature",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionsSystemDatadogExportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *permissionsSystemDatadogExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data permissionsSystemDatadogExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available from the configuration
+	var apiKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &apiKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, err := r.client.SetDatadogExportFeature(ctx, data.PermissionsSystemID.ValueString(), &models.SetDatadogExportFeatureRequest{
+		APIKey: apiKey.ValueString(),
+		Site:   data.Site.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure Datadog export feature, got error: %s", err))
+		return
+	}
+
+	setDatadogExportResourceData(&data, feature)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read only reconciles site and enabled, the API key is never returned by the API
+func (r *permissionsSystemDatadogExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data permissionsSystemDatadogExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, err := r.client.GetDatadogExportFeature(ctx, data.PermissionsSystemID.ValueString())
+	if err != nil {
+		apiErr := &client.APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// Permission system was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Datadog export feature, got error: %s", err))
+		return
+	}
+
+	// A feature disabled outside of Terraform is recreated, which sends the API key again
+	if !feature.Enabled {
+		tflog.Info(ctx, "Datadog export feature is disabled, removing it from state", map[string]any{
+			"permission_system_id": data.PermissionsSystemID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setDatadogExportResourceData(&data, feature)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemDatadogExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data permissionsSystemDatadogExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API requires the key on every write, so it is sent again together with the site
+	var apiKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &apiKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, err := r.client.SetDatadogExportFeature(ctx, data.PermissionsSystemID.ValueString(), &models.SetDatadogExportFeatureRequest{
+		APIKey: apiKey.ValueString(),
+		Site:   data.Site.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Datadog export feature, got error: %s", err))
+		return
+	}
+
+	setDatadogExportResourceData(&data, feature)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from state. The API has no way to disable the
+// Datadog export feature, so it stays enabled on the permission system.
+func (r *permissionsSystemDatadogExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data permissionsSystemDatadogExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Datadog export feature cannot be disabled through the API, removing it from state only", map[string]any{
+		"permission_system_id": data.PermissionsSystemID.ValueString(),
+	})
+	resp.Diagnostics.AddWarning(
+		"Datadog Export Still Enabled",
+		fmt.Sprintf("The Datadog export feature of permission system %s was removed from Terraform state but remains enabled. Disable it in the AuthZed dashboard if it is no longer needed.", data.PermissionsSystemID.ValueString()),
+	)
+}
+
+// ImportState imports the Datadog export feature using the permission system ID
+func (r *permissionsSystemDatadogExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), req.ID)...)
+}
+
+// setDatadogExportResourceData maps the API feature onto the resource model. The API key
+// is write-only and always left null in state.
+func setDatadogExportResourceData(data *permissionsSystemDatadogExportResourceModel, feature *models.DatadogExportFeature) {
+	data.ID = data.PermissionsSystemID
+	data.APIKey = types.StringNull()
+	data.Enabled = types.BoolValue(feature.Enabled)
+	data.DisplayName = types.StringValue(feature.DisplayName)
+	if feature.Config != nil && feature.Config.Site != "" {
+		data.Site = types.StringValue(feature.Config.Site)
+	}
+}
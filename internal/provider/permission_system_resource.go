@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var (
+	_ resource.Resource                = &permissionsSystemResource{}
+	_ resource.ResourceWithImportState = &permissionsSystemResource{}
+	_ resource.ResourceWithModifyPlan  = &permissionsSystemResource{}
+)
+
+func NewPermissionsSystemResource() resource.Resource {
+	return &permissionsSystemResource{}
+}
+
+type permissionsSystemResource struct {
+	client *client.CloudClient
+}
+
+type permissionsSystemResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	SystemType              types.String `tfsdk:"system_type"`
+	Channel                 types.String `tfsdk:"channel"`
+	ConfirmSystemTypeChange types.Bool   `tfsdk:"confirm_system_type_change"`
+	GlobalDnsPath           types.String `tfsdk:"global_dns_path"`
+	ETag                    types.String `tfsdk:"etag"`
+}
+
+func (r *permissionsSystemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_system"
+}
+
+func (r *permissionsSystemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of an existing permission system",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the existing permission system to manage",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the permission system",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Type of the permission system (development or production). Changing it requires confirm_system_type_change to be true",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(permissionsSystemTypes...),
+				},
+			},
+			"channel": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Update channel for SpiceDB versions (e.g., stable, rapid). Leave unset when authzed_permission_system_version manages the channel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"confirm_system_type_change": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Must be set to true to allow changing system_type between development and production",
+			},
+			"global_dns_path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Global DNS path for the permission system",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version identifier used to prevent conflicts from concurrent updates, ensuring safe resource modifications",
+			},
+		},
+	}
+}
+
+// permissionsSystemTypes are the values accepted by the API for systemType
+var permissionsSystemTypes = []string{"development", "production"}
+
+func (r *permissionsSystemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan rejects system type changes that have not been explicitly confirmed
+func (r *permissionsSystemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create or destroy, create is validated against the live system instead
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state permissionsSystemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SystemType.IsUnknown() || plan.SystemType.IsNull() || plan.SystemType.Equal(state.SystemType) {
+		return
+	}
+
+	if !plan.ConfirmSystemTypeChange.ValueBool() {
+		resp.Diagnostics.Append(systemTypeChangeNotConfirmed(state.SystemType.ValueString(), plan.SystemType.ValueString()))
+	}
+}
+
+func (r *permissionsSystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data permissionsSystemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The permission system must already exist, creating it adopts it into state
+	current, err := r.client.GetPermissionsSystem(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission system %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	if !data.SystemType.IsUnknown() && !data.SystemType.IsNull() &&
+		data.SystemType.ValueString() != current.PermissionsSystem.SystemType &&
+		!data.ConfirmSystemTypeChange.ValueBool() {
+		resp.Diagnostics.Append(systemTypeChangeNotConfirmed(current.PermissionsSystem.SystemType, data.SystemType.ValueString()))
+		return
+	}
+
+	if update := buildPermissionsSystemUpdate(current.PermissionsSystem, &data); update != nil {
+		current, err = r.client.UpdatePermissionsSystem(ctx, data.ID.ValueString(), update, current.ETag)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update permission system, got error: %s", err))
+			return
+		}
+	}
+
+	setPermissionsSystemResourceData(&data, current)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data permissionsSystemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsSystemWithETag, err := r.client.GetPermissionsSystem(ctx, data.ID.ValueString())
+	if err != nil {
+		apiErr := &client.APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// Permission system was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission system, got error: %s", err))
+		return
+	}
+
+	setPermissionsSystemResourceData(&data, permissionsSystemWithETag)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data permissionsSystemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the live system so that settings we don't manage (such as a version pin) are preserved
+	current, err := r.client.GetPermissionsSystem(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission system, got error: %s", err))
+		return
+	}
+
+	if update := buildPermissionsSystemUpdate(current.PermissionsSystem, &data); update != nil {
+		current, err = r.client.UpdatePermissionsSystem(ctx, data.ID.ValueString(), update, current.ETag)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update permission system, got error: %s", err))
+			return
+		}
+	}
+
+	setPermissionsSystemResourceData(&data, current)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data permissionsSystemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API accepts the delete asynchronously, the client polls until the system is gone
+	if err := r.client.DeletePermissionsSystem(data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting permission system", fmt.Sprintf("Unable to delete permission system: %v", err))
+		return
+	}
+}
+
+// ImportState handles importing an existing permission system into Terraform state
+func (r *permissionsSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildPermissionsSystemUpdate returns the update request needed to reconcile the live
+// permission system with the planned values, or nil when nothing needs to change
+func buildPermissionsSystemUpdate(current *models.PermissionsSystem, data *permissionsSystemResourceModel) *models.UpdatePermissionsSystemRequest {
+	update := &models.UpdatePermissionsSystemRequest{
+		Name:       current.Name,
+		SystemType: current.SystemType,
+		Channel:    current.Version.SelectedChannel,
+	}
+
+	// Preserve an existing version pin, omitting selectedVersion would move the system to automatic updates
+	if current.Version.IsLockedToVersion && current.Version.CurrentVersion.Version != "" {
+		selectedVersion := current.Version.CurrentVersion.Version
+		update.SelectedVersion = &selectedVersion
+	}
+
+	changed := false
+	if v := data.Name; !v.IsUnknown() && !v.IsNull() && v.ValueString() != current.Name {
+		update.Name = v.ValueString()
+		changed = true
+	}
+	if v := data.SystemType; !v.IsUnknown() && !v.IsNull() && v.ValueString() != current.SystemType {
+		update.SystemType = v.ValueString()
+		changed = true
+	}
+	if v := data.Channel; !v.IsUnknown() && !v.IsNull() && v.ValueString() != current.Version.SelectedChannel {
+		update.Channel = v.ValueString()
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return update
+}
+
+// setPermissionsSystemResourceData maps an API permission system onto the resource model
+func setPermissionsSystemResourceData(data *permissionsSystemResourceModel, permissionsSystemWithETag *client.PermissionsSystemWithETag) {
+	permissionsSystem := permissionsSystemWithETag.PermissionsSystem
+	data.ID = types.StringValue(permissionsSystem.ID)
+	data.Name = types.StringValue(permissionsSystem.Name)
+	data.SystemType = types.StringValue(permissionsSystem.SystemType)
+	data.Channel = types.StringValue(permissionsSystem.Version.SelectedChannel)
+	data.GlobalDnsPath = types.StringValue(permissionsSystem.GlobalDnsPath)
+	data.ETag = types.StringValue(permissionsSystemWithETag.ETag)
+}
+
+// systemTypeChangeNotConfirmed builds the diagnostic returned when system_type changes without confirmation
+func systemTypeChangeNotConfirmed(from, to string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("system_type"),
+		"System Type Change Not Confirmed",
+		fmt.Sprintf("Changing the permission system type from %q to %q must be confirmed by setting confirm_system_type_change = true.", from, to),
+	)
+}
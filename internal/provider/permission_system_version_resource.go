@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var (
+	_ resource.Resource                     = &permissionsSystemVersionResource{}
+	_ resource.ResourceWithImportState      = &permissionsSystemVersionResource{}
+	_ resource.ResourceWithConfigValidators = &permissionsSystemVersionResource{}
+)
+
+func NewPermissionsSystemVersionResource() resource.Resource {
+	return &permissionsSystemVersionResource{}
+}
+
+type permissionsSystemVersionResource struct {
+	client *client.CloudClient
+}
+
+type permissionsSystemVersionResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	PermissionsSystemID types.String   `tfsdk:"permission_system_id"`
+	Version             types.String   `tfsdk:"version"`
+	Channel             types.String   `tfsdk:"channel"`
+	CurrentVersion      types.String   `tfsdk:"current_version"`
+	IsLockedToVersion   types.Bool     `tfsdk:"is_locked_to_version"`
+	HasUpdateAvailable  types.Bool     `tfsdk:"has_update_available"`
+	Status              types.String   `tfsdk:"status"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *permissionsSystemVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_system_version"
+}
+
+func (r *permissionsSystemVersionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pins a permission system to a SpiceDB version or moves it to an update channel, and waits for the upgrade to complete",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for this resource, equal to the permission system ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission_system_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the permission system to upgrade",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "SpiceDB version to pin the permission system to. When unset, the system follows its update channel",
+			},
+			"channel": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Update channel for SpiceDB versions (e.g., stable, rapid). Must not also be set on authzed_permission_system",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"current_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "SpiceDB version currently running",
+			},
+			"is_locked_to_version": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the permission system is pinned to a specific version",
+			},
+			"has_update_available": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a newer version is available on the selected channel",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the permission system",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *permissionsSystemVersionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("version"),
+			path.MatchRoot("channel"),
+		),
+	}
+}
+
+func (r *permissionsSystemVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *permissionsSystemVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data permissionsSystemVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	r.apply(createCtx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data permissionsSystemVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsSystemWithETag, err := r.client.GetPermissionsSystem(ctx, data.PermissionsSystemID.ValueString())
+	if err != nil {
+		apiErr := &client.APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// Permission system was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission system, got error: %s", err))
+		return
+	}
+
+	permissionsSystem := permissionsSystemWithETag.PermissionsSystem
+
+	// Surface pins changed outside of Terraform as drift on version
+	if !data.Version.IsNull() {
+		if permissionsSystem.Version.IsLockedToVersion {
+			data.Version = types.StringValue(permissionsSystem.Version.CurrentVersion.Version)
+		} else {
+			data.Version = types.StringNull()
+		}
+	}
+
+	setPermissionsSystemVersionResourceData(&data, permissionsSystem)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsSystemVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data permissionsSystemVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.apply(updateCtx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from state and leaves the permission system on its current version
+func (r *permissionsSystemVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data permissionsSystemVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+}
+
+// ImportState imports the version settings using the permission system ID
+func (r *permissionsSystemVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), req.ID)...)
+}
+
+// apply sends the version settings to the API when they differ from the live system,
+// then waits until the permission system is running the expected version
+func (r *permissionsSystemVersionResource) apply(ctx context.Context, data *permissionsSystemVersionResourceModel, diags *diag.Diagnostics) {
+	psID := data.PermissionsSystemID.ValueString()
+
+	current, err := r.client.GetPermissionsSystem(ctx, psID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read permission system %s, got error: %s", psID, err))
+		return
+	}
+
+	upgrade := permissionSystemUpgrade{
+		TargetVersion:   data.Version.ValueString(),
+		PreviousVersion: current.PermissionsSystem.Version.CurrentVersion.Version,
+	}
+	if update := buildPermissionsSystemVersionUpdate(current.PermissionsSystem, data); update != nil {
+		if _, err := r.client.UpdatePermissionsSystem(ctx, psID, update, current.ETag); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update permission system version, got error: %s", err))
+			return
+		}
+		// Channel moves and unpins upgrade the permission system when a newer version is available
+		upgrade.Expected = upgrade.TargetVersion == "" && current.PermissionsSystem.Version.HasUpdateAvailable
+	}
+
+	running, err := waitForPermissionSystemRunning(ctx, r.client, psID, upgrade)
+	if err != nil {
+		upgradeErr := &permissionSystemUpgradeError{}
+		if errors.As(err, &upgradeErr) {
+			diags.AddAttributeError(
+				path.Root("version"),
+				"SpiceDB Upgrade Failed",
+				fmt.Sprintf("Permission system %s failed to upgrade: %s", psID, upgradeErr.Message),
+			)
+			return
+		}
+
+		diags.AddError("Upgrade Error", fmt.Sprintf("Error waiting for permission system %s to be running: %s", psID, err))
+		return
+	}
+
+	setPermissionsSystemVersionResourceData(data, running.PermissionsSystem)
+}
+
+// buildPermissionsSystemVersionUpdate returns the update request that pins the permission system
+// to the planned version or moves it to the planned channel, or nil when nothing needs to change.
+// The name and system type are sent unchanged.
+func buildPermissionsSystemVersionUpdate(current *models.PermissionsSystem, data *permissionsSystemVersionResourceModel) *models.UpdatePermissionsSystemRequest {
+	update := &models.UpdatePermissionsSystemRequest{
+		Name:       current.Name,
+		SystemType: current.SystemType,
+		Channel:    current.Version.SelectedChannel,
+	}
+
+	changed := false
+	if v := data.Channel; !v.IsUnknown() && !v.IsNull() && v.ValueString() != current.Version.SelectedChannel {
+		update.Channel = v.ValueString()
+		changed = true
+	}
+
+	if v := data.Version; !v.IsUnknown() && !v.IsNull() {
+		selectedVersion := v.ValueString()
+		update.SelectedVersion = &selectedVersion
+		if !current.Version.IsLockedToVersion || current.Version.CurrentVersion.Version != selectedVersion {
+			changed = true
+		}
+	} else if current.Version.IsLockedToVersion {
+		// Omitting selectedVersion unpins the system and moves it to its channel
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return update
+}
+
+// setPermissionsSystemVersionResourceData maps the version fields of an API permission system onto the resource model
+func setPermissionsSystemVersionResourceData(data *permissionsSystemVersionResourceModel, permissionsSystem *models.PermissionsSystem) {
+	data.ID = types.StringValue(permissionsSystem.ID)
+	data.PermissionsSystemID = types.StringValue(permissionsSystem.ID)
+	data.Channel = types.StringValue(permissionsSystem.Version.SelectedChannel)
+	data.CurrentVersion = types.StringValue(permissionsSystem.Version.CurrentVersion.Version)
+	data.IsLockedToVersion = types.BoolValue(permissionsSystem.Version.IsLockedToVersion)
+	data.HasUpdateAvailable = types.BoolValue(permissionsSystem.Version.HasUpdateAvailable)
+	data.Status = types.StringValue(permissionsSystem.SystemState.Status)
+}
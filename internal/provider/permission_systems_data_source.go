@@ -32,6 +32,10 @@ type permissionsSystemModelForList struct {
 	Name          types.String `tfsdk:"name"`
 	GlobalDnsPath types.String `tfsdk:"global_dns_path"`
 	SystemType    types.String `tfsdk:"system_type"`
+	Version       types.Object `tfsdk:"version"`
+	Capabilities  types.List   `tfsdk:"capabilities"`
+	Features      types.List   `tfsdk:"features"`
+	Datastore     types.Object `tfsdk:"datastore"`
 }
 
 func (d *permissionsSystemsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -67,6 +71,28 @@ func (d *permissionsSystemsDataSource) Schema(_ context.Context, _ datasource.Sc
 							Computed:    true,
 							Description: "Type of the permission system (development or production)",
 						},
+						"version": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Version information for the permission system",
+							Attributes:  permissionsSystemVersionAttributes(),
+						},
+						"capabilities": schema.ListAttribute{
+							Computed:    true,
+							Description: "Capabilities of the permission system (e.g., role_management, version_pinning)",
+							ElementType: types.StringType,
+						},
+						"features": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Features of the permission system and whether they are enabled",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: permissionsSystemFeatureAttributes(),
+							},
+						},
+						"datastore": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Datastore backing the permission system",
+							Attributes:  permissionsSystemDatastoreAttributes(),
+						},
 					},
 				},
 			},
@@ -111,11 +137,21 @@ func (d *permissionsSystemsDataSource) Read(ctx context.Context, req datasource.
 
 	permissionsSystemsList := []permissionsSystemModelForList{}
 	for _, ps := range permissionsSystems {
+		details, diags := newPermissionsSystemDetails(ctx, &ps)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		permissionsSystemsList = append(permissionsSystemsList, permissionsSystemModelForList{
 			ID:            types.StringValue(ps.ID),
 			Name:          types.StringValue(ps.Name),
 			GlobalDnsPath: types.StringValue(ps.GlobalDnsPath),
 			SystemType:    types.StringValue(ps.SystemType),
+			Version:       details.Version,
+			Capabilities:  details.Capabilities,
+			Features:      details.Features,
+			Datastore:     details.Datastore,
 		})
 	}
 
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &policyResource{}
+	_ list.ListResourceWithConfigure = &policyResource{}
+)
+
+func NewPolicyListResource() list.ListResource {
+	return &policyResource{}
+}
+
+func (r *policyResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = permissionsSystemListConfigSchema("Lists the policies of a permission system")
+}
+
+// List streams the policies of a permission system
+func (r *policyResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config permissionsSystemListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	policies, err := r.client.ListPolicies(config.PermissionsSystemID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list policies, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, policies, func(policy models.Policy, result *list.ListResult) {
+		result.DisplayName = policy.Name
+		result.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, result.Identity, config.PermissionsSystemID, types.StringValue(policy.ID))...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(r.listResultResource(ctx, config.PermissionsSystemID, policy, result)...)
+		}
+	})
+}
+
+// listResultResource sets the resource of a list result from a listed policy
+func (r *policyResource) listResultResource(ctx context.Context, permissionsSystemID types.String, policy models.Policy, result *list.ListResult) diag.Diagnostics {
+	data := policyResourceModel{
+		ID:                  types.StringValue(policy.ID),
+		Name:                types.StringValue(policy.Name),
+		Description:         types.StringValue(policy.Description),
+		PermissionsSystemID: permissionsSystemID,
+		PrincipalID:         types.StringValue(policy.PrincipalID),
+		CreatedAt:           types.StringValue(policy.CreatedAt),
+		Creator:             stringValueOrNull(policy.Creator),
+		UpdatedAt:           stringValueOrNull(policy.UpdatedAt),
+		Updater:             stringValueOrNull(policy.Updater),
+		// The list response carries no ETag, the next refresh stores it
+		ETag: types.StringNull(),
+	}
+
+	roleIDs, diags := types.ListValueFrom(ctx, types.StringType, policy.RoleIDs)
+	if diags.HasError() {
+		return diags
+	}
+	data.RoleIDs = roleIDs
+
+	diags.Append(result.Resource.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(result.Resource.Set(ctx, &data)...)
+	return diags
+}
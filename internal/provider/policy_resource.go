@@ -16,12 +16,14 @@ import (
 
 	"terraform-provider-authzed/internal/client"
 	"terraform-provider-authzed/internal/models"
+	"terraform-provider-authzed/internal/provider/concurrency"
 	"terraform-provider-authzed/internal/provider/pslanes"
 )
 
 var (
 	_ resource.Resource                = &policyResource{}
 	_ resource.ResourceWithImportState = &policyResource{}
+	_ resource.ResourceWithIdentity    = &policyResource{}
 )
 
 func NewPolicyResource() resource.Resource {
@@ -31,6 +33,7 @@ func NewPolicyResource() resource.Resource {
 type policyResource struct {
 	client  *client.CloudClient
 	psLanes *pslanes.PSLanes
+	limits  *concurrency.Limits
 }
 
 type policyResourceModel struct {
@@ -136,6 +139,7 @@ func (r *policyResource) Configure(_ context.Context, req resource.ConfigureRequ
 
 	r.client = providerData.Client
 	r.psLanes = providerData.PSLanes
+	r.limits = providerData.Limits
 }
 
 func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -200,12 +204,14 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 		RoleIDs:             roleIDs,
 	}
 
-	// Serialize policy creation per Permission System to prevent FGAM conflicts
+	// Create the policy in the adaptive create lane of its Permission System, which narrows on FGAM conflicts
 	var createdPolicyWithETag *client.PolicyWithETag
-	err := r.psLanes.WithCreateLane(createCtx, policy.PermissionsSystemID, func() error {
-		var createErr error
-		createdPolicyWithETag, createErr = r.client.CreatePolicy(createCtx, policy)
-		return createErr
+	err := r.limits.With(createCtx, concurrency.Policies, func() error {
+		return r.psLanes.WithCreateLane(createCtx, policy.PermissionsSystemID, func() error {
+			var createErr error
+			createdPolicyWithETag, createErr = r.client.CreatePolicy(createCtx, policy)
+			return createErr
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating Policy", err.Error())
@@ -244,6 +250,7 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Skip post-create stabilization; rely on POST response and next Read
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -301,6 +308,7 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 	data.RoleIDs = roleIDList
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -340,7 +348,12 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		policy.Creator = state.Creator.ValueString()
 	}
 
-	updatedPolicyWithETag, err := r.client.UpdatePolicy(ctx, policy, state.ETag.ValueString())
+	var updatedPolicyWithETag *client.PolicyWithETag
+	err := r.limits.With(ctx, concurrency.Policies, func() error {
+		var updateErr error
+		updatedPolicyWithETag, updateErr = r.client.UpdatePolicy(ctx, policy, state.ETag.ValueString())
+		return updateErr
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy, got error: %s", err))
 		return
@@ -374,6 +387,7 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data.RoleIDs = roleIDList
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -396,10 +410,12 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	permissionSystemID := data.PermissionsSystemID.ValueString()
 
-	// Serialize policy deletion per Permission System with 409 retry
-	err := r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
-		return pslanes.Retry409Delete(deleteCtx, func() error {
-			return r.client.DeletePolicy(permissionSystemID, data.ID.ValueString())
+	// Delete the policy in the adaptive delete lane of its Permission System, with 409 retry
+	err := r.limits.With(deleteCtx, concurrency.Policies, func() error {
+		return r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
+			return pslanes.Retry409Delete(deleteCtx, func() error {
+				return r.client.DeletePolicy(permissionSystemID, data.ID.ValueString())
+			})
 		})
 	})
 	if err != nil {
@@ -408,7 +424,18 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// IdentitySchema defines the identity of the policy, which import blocks can use instead of an import ID
+func (r *policyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = permissionsSystemChildIdentitySchema("The ID of the policy")
+}
+
 func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import blocks may identify the resource with an identity instead of an import ID
+	if req.ID == "" {
+		importPermissionsSystemChildIdentity(ctx, req, resp)
+		return
+	}
+
 	// Import ID format: permission_system_id:policy_id
 	idParts := strings.Split(req.ID, ":")
 	if len(idParts) != 2 {
@@ -425,6 +452,7 @@ func (r *policyResource) ImportState(ctx context.Context, req resource.ImportSta
 	// Set the main identifiers
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), permissionSystemID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), policyID)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, types.StringValue(permissionSystemID), types.StringValue(policyID))...)
 
 	// Terraform automatically calls Read to fetch the rest of the attributes
 }
@@ -2,14 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/provider/concurrency"
 	"terraform-provider-authzed/internal/provider/pslanes"
 )
 
@@ -27,15 +39,27 @@ type CloudProviderModel struct {
 	MaxConcurrentTokens          types.Int64  `tfsdk:"max_concurrent_tokens"`
 	MaxConcurrentPolicies        types.Int64  `tfsdk:"max_concurrent_policies"`
 	MaxConcurrentRoles           types.Int64  `tfsdk:"max_concurrent_roles"`
+	UnsupportedPermissions       types.String `tfsdk:"unsupported_permissions"`
 }
 
 // CloudProviderData contains the configured client and essential components
 type CloudProviderData struct {
 	Client  *client.CloudClient
 	PSLanes *pslanes.PSLanes
+	// Limits bounds the concurrent mutating operations on roles, policies, service accounts and tokens
+	Limits *concurrency.Limits
+	// UnsupportedPermissions is the severity of role permissions the SpiceDB version does not support
+	UnsupportedPermissions string
+	SupportedAPIs          *supportedAPIsCache
 }
 
-var _ provider.Provider = &CloudProvider{}
+var (
+	_ provider.Provider                       = &CloudProvider{}
+	_ provider.ProviderWithFunctions          = &CloudProvider{}
+	_ provider.ProviderWithEphemeralResources = &CloudProvider{}
+	_ provider.ProviderWithListResources      = &CloudProvider{}
+	_ provider.ProviderWithActions            = &CloudProvider{}
+)
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -72,23 +96,42 @@ func (p *CloudProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			},
 			"auto_parallelism": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Enable automatic parallelism recommendations based on resource count. Can also be set via AUTHZED_AUTO_PARALLELISM.",
+				Description: "Enable automatic parallelism recommendations based on resource count. Once a run exceeds the resource counts at which `-parallelism=1` is recommended, the provider serializes role, policy, service account and token operations and logs a warning. Can also be set via AUTHZED_AUTO_PARALLELISM.",
 			},
 			"max_concurrent_service_accounts": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Maximum number of concurrent service account operations (default: 6). Can also be set via AUTHZED_MAX_CONCURRENT_SERVICE_ACCOUNTS.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 			"max_concurrent_tokens": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Maximum number of concurrent token operations (default: 8). Can also be set via AUTHZED_MAX_CONCURRENT_TOKENS.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 			"max_concurrent_policies": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Maximum number of concurrent policy operations (default: 3). Can also be set via AUTHZED_MAX_CONCURRENT_POLICIES.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 			"max_concurrent_roles": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Maximum number of concurrent role operations (default: 3). Can also be set via AUTHZED_MAX_CONCURRENT_ROLES.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"unsupported_permissions": schema.StringAttribute{
+				Optional:    true,
+				Description: "Whether role permissions that the SpiceDB version of the permission system does not support are reported as a warning or an error at plan time (default: warning).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(UnsupportedPermissionsWarning, UnsupportedPermissionsError),
+				},
 			},
 		},
 	}
@@ -109,16 +152,87 @@ func (p *CloudProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	cloudClient := client.NewCloudClient(clientConfig)
 
-	// Initialize PSLanes for per-Permission System serialization
+	// Initialize PSLanes for per-Permission System concurrency, narrowing them whenever the API
+	// reports FGAM conflicts or rate limiting
 	psLanes := pslanes.NewPSLanes()
+	cloudClient.OnBackOff = psLanes.BackOff
+
+	limitsConfig := concurrency.Config{
+		MaxConcurrent: map[concurrency.Kind]int64{
+			concurrency.ServiceAccounts: maxConcurrentSetting(config.MaxConcurrentServiceAccounts, "max_concurrent_service_accounts", "AUTHZED_MAX_CONCURRENT_SERVICE_ACCOUNTS", concurrency.DefaultMaxConcurrentServiceAccounts, resp),
+			concurrency.Tokens:          maxConcurrentSetting(config.MaxConcurrentTokens, "max_concurrent_tokens", "AUTHZED_MAX_CONCURRENT_TOKENS", concurrency.DefaultMaxConcurrentTokens, resp),
+			concurrency.Policies:        maxConcurrentSetting(config.MaxConcurrentPolicies, "max_concurrent_policies", "AUTHZED_MAX_CONCURRENT_POLICIES", concurrency.DefaultMaxConcurrentPolicies, resp),
+			concurrency.Roles:           maxConcurrentSetting(config.MaxConcurrentRoles, "max_concurrent_roles", "AUTHZED_MAX_CONCURRENT_ROLES", concurrency.DefaultMaxConcurrentRoles, resp),
+		},
+		AutoParallelism: autoParallelismSetting(config.AutoParallelism, resp),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unsupportedPermissions := UnsupportedPermissionsWarning
+	if !config.UnsupportedPermissions.IsNull() {
+		unsupportedPermissions = config.UnsupportedPermissions.ValueString()
+	}
 
 	providerData := &CloudProviderData{
-		Client:  cloudClient,
-		PSLanes: psLanes,
+		Client:                 cloudClient,
+		PSLanes:                psLanes,
+		Limits:                 concurrency.NewLimits(limitsConfig),
+		UnsupportedPermissions: unsupportedPermissions,
+		SupportedAPIs:          newSupportedAPIsCache(),
 	}
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ListResourceData = providerData
+	resp.ActionData = providerData
+}
+
+// maxConcurrentSetting returns the configured limit of a max_concurrent_* setting, falling back to its
+// environment variable and then to the default
+func maxConcurrentSetting(value types.Int64, attribute, envVar string, defaultLimit int64, resp *provider.ConfigureResponse) int64 {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64()
+	}
+
+	env := os.Getenv(envVar)
+	if env == "" {
+		return defaultLimit
+	}
+	limit, err := strconv.ParseInt(env, 10, 64)
+	if err != nil || limit < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Concurrency Limit",
+			fmt.Sprintf("%s must be a number of at least 1, got: %q", envVar, env),
+		)
+		return defaultLimit
+	}
+	return limit
+}
+
+// autoParallelismSetting returns whether auto parallelism is enabled, falling back to AUTHZED_AUTO_PARALLELISM
+func autoParallelismSetting(value types.Bool, resp *provider.ConfigureResponse) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+
+	env := os.Getenv("AUTHZED_AUTO_PARALLELISM")
+	if env == "" {
+		return false
+	}
+	enabled, err := strconv.ParseBool(env)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auto_parallelism"),
+			"Invalid Auto Parallelism Setting",
+			fmt.Sprintf("AUTHZED_AUTO_PARALLELISM must be true or false, got: %q", env),
+		)
+		return false
+	}
+	return enabled
 }
 
 func (p *CloudProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -127,6 +241,12 @@ func (p *CloudProvider) Resources(_ context.Context) []func() resource.Resource
 		NewPolicyResource,
 		NewServiceAccountResource,
 		NewTokenResource,
+		NewPermissionsSystemResource,
+		NewDatastoreResource,
+		NewPermissionsSystemAuditLogResource,
+		NewPermissionsSystemDatadogExportResource,
+		NewExternalMetricsTokenResource,
+		NewPermissionsSystemVersionResource,
 	}
 	return resources
 }
@@ -143,6 +263,40 @@ func (p *CloudProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewServiceAccountsDataSource,
 		NewTokenDataSource,
 		NewTokensDataSource,
+		NewRegionsDataSource,
+		NewSpiceDBChannelsDataSource,
+		NewDatastoreTemplatesDataSource,
+		NewDeploymentsDataSource,
+		NewMaterializeClustersDataSource,
+		NewPerformanceInsightsDataSource,
 	}
 	return dataSources
 }
+
+func (p *CloudProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTokenEphemeralResource,
+	}
+}
+
+func (p *CloudProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewRoleListResource,
+		NewPolicyListResource,
+		NewServiceAccountListResource,
+		NewTokenListResource,
+	}
+}
+
+func (p *CloudProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRotateTokenAction,
+		NewRevokeServiceAccountTokensAction,
+	}
+}
+
+func (p *CloudProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewValidatePermissionExpressionFunction,
+	}
+}
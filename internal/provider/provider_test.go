@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
 	"terraform-provider-authzed/internal/test/helpers"
@@ -32,6 +36,55 @@ func testAccProviderConfig() string {
 	return helpers.BuildProviderConfig()
 }
 
+// testConfiguredProviderServer returns a provider server configured against the given endpoint, and its schemas
+func testConfiguredProviderServer(t *testing.T, endpoint string) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	providerServer, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	providerConfig := testDynamicValue(t, schemaResp.Provider, map[string]tftypes.Value{
+		"endpoint":    tftypes.NewValue(tftypes.String, endpoint),
+		"token":       tftypes.NewValue(tftypes.String, "test-token"),
+		"api_version": tftypes.NewValue(tftypes.String, "v1"),
+	})
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: providerConfig})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error configuring provider: %v %v", err, configureResp.Diagnostics)
+	}
+
+	return providerServer, schemaResp
+}
+
+// testDynamicValue encodes a configuration for the schema, leaving unset attributes and blocks null
+func testDynamicValue(t *testing.T, schema *tfprotov6.Schema, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	objectType := schema.ValueType().(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return &value
+}
+
 // TestProvider verifies that the provider can be instantiated
 func TestProvider(t *testing.T) {
 	p := New("dev")()
@@ -40,6 +93,56 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// TestProviderSchema verifies that the schemas of the provider and all its resources, data sources
+// and functions pass the framework's validation, e.g. that no reserved attribute names are used
+func TestProviderSchema(t *testing.T) {
+	providerServer, err := testAccProtoV6ProviderFactories["authzed"]()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := providerServer.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+}
+
+// TestProviderConcurrencySettings verifies that the max_concurrent_* and auto_parallelism settings
+// fall back to their environment variables and defaults
+func TestProviderConcurrencySettings(t *testing.T) {
+	t.Setenv("AUTHZED_MAX_CONCURRENT_TOKENS", "2")
+	t.Setenv("AUTHZED_AUTO_PARALLELISM", "true")
+
+	resp := &provider.ConfigureResponse{}
+	if limit := maxConcurrentSetting(types.Int64Value(5), "max_concurrent_tokens", "AUTHZED_MAX_CONCURRENT_TOKENS", 8, resp); limit != 5 {
+		t.Errorf("Expected the configured limit 5, got %d", limit)
+	}
+	if limit := maxConcurrentSetting(types.Int64Null(), "max_concurrent_tokens", "AUTHZED_MAX_CONCURRENT_TOKENS", 8, resp); limit != 2 {
+		t.Errorf("Expected the environment limit 2, got %d", limit)
+	}
+	if limit := maxConcurrentSetting(types.Int64Null(), "max_concurrent_roles", "AUTHZED_MAX_CONCURRENT_ROLES", 3, resp); limit != 3 {
+		t.Errorf("Expected the default limit 3, got %d", limit)
+	}
+	if !autoParallelismSetting(types.BoolNull(), resp) {
+		t.Error("Expected auto parallelism to be enabled from the environment")
+	}
+	if autoParallelismSetting(types.BoolValue(false), resp) {
+		t.Error("Expected the configured auto parallelism to take precedence")
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	t.Setenv("AUTHZED_MAX_CONCURRENT_ROLES", "0")
+	maxConcurrentSetting(types.Int64Null(), "max_concurrent_roles", "AUTHZED_MAX_CONCURRENT_ROLES", 3, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for a limit below 1")
+	}
+}
+
 // TestAccProvider verifies that the provider can be configured for acceptance testing
 func TestAccProvider(t *testing.T) {
 	resource.Test(t, resource.TestCase{
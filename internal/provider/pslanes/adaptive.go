@@ -0,0 +1,129 @@
+package pslanes
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-authzed/internal/client"
+)
+
+// Bounds of the adaptive lane limits. Lanes start serialized and widen while operations succeed.
+const (
+	minLaneLimit = 1.0
+	maxLaneLimit = 8.0
+	// backOffCooldown ignores further back off signals right after a decrease, since the operations
+	// that were already in flight typically report the same conflict
+	backOffCooldown = time.Second
+)
+
+// now returns the current time, and is replaced in tests
+var now = time.Now
+
+// adaptiveLane limits the concurrent operations of a lane with additive increase, multiplicative
+// decrease (AIMD): the limit grows by one for every window of successful operations and is halved
+// when the API reports FGAM conflicts or rate limiting
+type adaptiveLane struct {
+	lane                string
+	permissionsSystemID string
+
+	mutex       sync.Mutex
+	limit       float64
+	inFlight    int
+	lastBackOff time.Time
+	// released is closed and replaced whenever a waiting operation may be able to start
+	released chan struct{}
+}
+
+func newAdaptiveLane(lane, permissionsSystemID string) *adaptiveLane {
+	return &adaptiveLane{
+		lane:                lane,
+		permissionsSystemID: permissionsSystemID,
+		limit:               minLaneLimit,
+		released:            make(chan struct{}),
+	}
+}
+
+// acquire waits until the lane has room for another operation
+func (l *adaptiveLane) acquire(ctx context.Context) error {
+	for {
+		l.mutex.Lock()
+		if l.inFlight < int(l.limit) {
+			l.inFlight++
+			l.mutex.Unlock()
+			return nil
+		}
+		released := l.released
+		l.mutex.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// release ends an operation and adapts the limit to its outcome
+func (l *adaptiveLane) release(ctx context.Context, err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.inFlight--
+	apiErr := &client.APIError{}
+	switch {
+	case err == nil:
+		l.increase(ctx)
+	case errors.As(err, &apiErr) && apiErr.ShouldBackOff():
+		l.decrease(ctx, apiErr)
+	}
+	l.signal()
+}
+
+// backOff halves the limit after the API asked to reduce concurrency
+func (l *adaptiveLane) backOff(ctx context.Context, apiErr *client.APIError) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.decrease(ctx, apiErr)
+}
+
+// increase widens the lane by one operation per window of successful operations. The caller must hold the mutex.
+func (l *adaptiveLane) increase(ctx context.Context) {
+	previous := int(l.limit)
+	l.limit = min(maxLaneLimit, l.limit+1/l.limit)
+	if int(l.limit) > previous {
+		tflog.Debug(ctx, "Widening permission system lane", map[string]any{
+			"permission_system_id": l.permissionsSystemID,
+			"lane":                 l.lane,
+			"limit":                int(l.limit),
+		})
+	}
+}
+
+// decrease halves the lane, at most once per cooldown. The caller must hold the mutex.
+func (l *adaptiveLane) decrease(ctx context.Context, apiErr *client.APIError) {
+	if now().Sub(l.lastBackOff) < backOffCooldown {
+		return
+	}
+	l.lastBackOff = now()
+
+	previous := int(l.limit)
+	l.limit = max(minLaneLimit, l.limit/2)
+	tflog.Info(ctx, "Backing off permission system lane", map[string]any{
+		"permission_system_id": l.permissionsSystemID,
+		"lane":                 l.lane,
+		"status_code":          apiErr.StatusCode,
+		"previous_limit":       previous,
+		"limit":                int(l.limit),
+	})
+}
+
+// signal wakes up waiting operations. The caller must hold the mutex.
+func (l *adaptiveLane) signal() {
+	close(l.released)
+	l.released = make(chan struct{})
+}
@@ -0,0 +1,117 @@
+package pslanes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"terraform-provider-authzed/internal/client"
+)
+
+func TestAdaptiveLane(t *testing.T) {
+	current := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = time.Now })
+
+	ctx := context.Background()
+	lanes := NewPSLanes()
+	lane := lanes.getCreateLane("ps-123")
+
+	// Successful operations widen the lane up to its maximum
+	for i := 0; i < 100; i++ {
+		if err := lanes.WithCreateLane(ctx, "ps-123", func() error { return nil }); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if lane.limit != maxLaneLimit {
+		t.Errorf("Expected the lane to widen to %v, got %v", maxLaneLimit, lane.limit)
+	}
+
+	// A rate limited operation halves it
+	rateLimited := &client.APIError{StatusCode: http.StatusTooManyRequests}
+	_ = lanes.WithCreateLane(ctx, "ps-123", func() error { return rateLimited })
+	if lane.limit != maxLaneLimit/2 {
+		t.Errorf("Expected the lane to back off to %v, got %v", maxLaneLimit/2, lane.limit)
+	}
+
+	// Further signals during the cooldown are ignored
+	lanes.BackOff(ctx, "ps-123", rateLimited)
+	if lane.limit != maxLaneLimit/2 {
+		t.Errorf("Expected back off to be ignored during the cooldown, got %v", lane.limit)
+	}
+
+	// FGAM conflicts reported by the client narrow the lane down to serialization
+	conflict := &client.APIError{StatusCode: http.StatusConflict, Message: "restricted API access configuration has changed"}
+	for i := 0; i < 5; i++ {
+		current = current.Add(backOffCooldown)
+		lanes.BackOff(ctx, "ps-123", conflict)
+	}
+	if lane.limit != minLaneLimit {
+		t.Errorf("Expected the lane to back off to %v, got %v", minLaneLimit, lane.limit)
+	}
+
+	// Other errors leave the lane unchanged
+	_ = lanes.WithCreateLane(ctx, "ps-123", func() error { return &client.APIError{StatusCode: http.StatusBadRequest} })
+	if lane.limit != minLaneLimit {
+		t.Errorf("Expected the lane to stay at %v, got %v", minLaneLimit, lane.limit)
+	}
+}
+
+func TestAdaptiveLane_Limit(t *testing.T) {
+	ctx := context.Background()
+	lane := newAdaptiveLane("create", "ps-123")
+	lane.limit = 2
+
+	for i := 0; i < 2; i++ {
+		if err := lane.acquire(ctx); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// A third operation waits until one of the others completes
+	acquired := make(chan error, 1)
+	go func() { acquired <- lane.acquire(ctx) }()
+	select {
+	case <-acquired:
+		t.Fatal("Expected the third operation to wait")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	lane.release(ctx, nil)
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the third operation to start after a release")
+	}
+
+	// Waiting operations give up when their context is done
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := lane.acquire(cancelled); err == nil {
+		t.Error("Expected an error for a cancelled context")
+	}
+}
+
+func TestWithLane_Panic(t *testing.T) {
+	ctx := context.Background()
+	lanes := NewPSLanes()
+
+	func() {
+		defer func() { _ = recover() }()
+		_ = lanes.WithDeleteLane(ctx, "ps-123", func() error { panic("boom") })
+	}()
+	if limit := lanes.getDeleteLane("ps-123").limit; limit != minLaneLimit {
+		t.Errorf("Expected a panicking operation not to widen the lane, got %v", limit)
+	}
+
+	// The slot of the panicking operation must be free again
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if err := lanes.WithDeleteLane(ctx, "ps-123", func() error { return nil }); err != nil {
+		t.Fatalf("Expected the lane to be released after a panic, got %v", err)
+	}
+}
@@ -2,54 +2,64 @@ package pslanes
 
 import (
 	"context"
+	"errors"
 	"sync"
+
+	"terraform-provider-authzed/internal/client"
 )
 
-// PSLanes provides per-Permission System serialization for access operations
+// PSLanes provides per-Permission System concurrency control for access operations. Each lane starts
+// serialized and adapts its concurrency to the FGAM conflicts and rate limits reported by the API.
 type PSLanes struct {
-	// createLanes provides per-PS serialization for create operations
-	createLanes map[string]chan struct{}
-	// deleteLanes provides per-PS serialization for delete operations
-	deleteLanes map[string]chan struct{}
+	// createLanes limits concurrent create operations per PS
+	createLanes map[string]*adaptiveLane
+	// deleteLanes limits concurrent delete operations per PS
+	deleteLanes map[string]*adaptiveLane
 	mutex       sync.RWMutex
 }
 
 // NewPSLanes creates a new PSLanes instance
 func NewPSLanes() *PSLanes {
 	return &PSLanes{
-		createLanes: make(map[string]chan struct{}),
-		deleteLanes: make(map[string]chan struct{}),
+		createLanes: make(map[string]*adaptiveLane),
+		deleteLanes: make(map[string]*adaptiveLane),
 	}
 }
 
-// WithCreateLane executes the given function with create lane serialization for the specified Permission System
+// WithCreateLane executes the given function in the create lane of the specified Permission System
 func (p *PSLanes) WithCreateLane(ctx context.Context, psID string, fn func() error) error {
-	lane := p.getCreateLane(psID)
-
-	select {
-	case lane <- struct{}{}:
-		defer func() { <-lane }()
-		return fn()
-	case <-ctx.Done():
-		return ctx.Err()
-	}
+	return withLane(ctx, p.getCreateLane(psID), fn)
 }
 
-// WithDeleteLane executes the given function with delete lane serialization for the specified Permission System
+// WithDeleteLane executes the given function in the delete lane of the specified Permission System
 func (p *PSLanes) WithDeleteLane(ctx context.Context, psID string, fn func() error) error {
-	lane := p.getDeleteLane(psID)
-
-	select {
-	case lane <- struct{}{}:
-		defer func() { <-lane }()
-		return fn()
-	case <-ctx.Done():
-		return ctx.Err()
+	return withLane(ctx, p.getDeleteLane(psID), fn)
+}
+
+// BackOff narrows the lanes of the specified Permission System after the API reported an FGAM
+// conflict or rate limiting. It is called for every such response, including those retried by the client.
+func (p *PSLanes) BackOff(ctx context.Context, psID string, apiErr *client.APIError) {
+	p.getCreateLane(psID).backOff(ctx, apiErr)
+	p.getDeleteLane(psID).backOff(ctx, apiErr)
+}
+
+// errOperationAborted is the outcome of an operation that did not return
+var errOperationAborted = errors.New("operation aborted")
+
+func withLane(ctx context.Context, lane *adaptiveLane, fn func() error) (err error) {
+	if err := lane.acquire(ctx); err != nil {
+		return err
 	}
+	// Release in a defer so that the slot is freed even if fn panics, in which case err is still
+	// errOperationAborted and the lane does not widen
+	err = errOperationAborted
+	defer func() { lane.release(ctx, err) }()
+
+	return fn()
 }
 
-// getCreateLane returns the create lane for the specified Permission System (capacity=1 for serialization)
-func (p *PSLanes) getCreateLane(psID string) chan struct{} {
+// getCreateLane returns the create lane for the specified Permission System
+func (p *PSLanes) getCreateLane(psID string) *adaptiveLane {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
@@ -57,13 +67,13 @@ func (p *PSLanes) getCreateLane(psID string) chan struct{} {
 		return lane
 	}
 
-	lane := make(chan struct{}, 1) // capacity=1 for serialization
+	lane := newAdaptiveLane("create", psID)
 	p.createLanes[psID] = lane
 	return lane
 }
 
-// getDeleteLane returns the delete lane for the specified Permission System (capacity=1 for serialization)
-func (p *PSLanes) getDeleteLane(psID string) chan struct{} {
+// getDeleteLane returns the delete lane for the specified Permission System
+func (p *PSLanes) getDeleteLane(psID string) *adaptiveLane {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
@@ -71,7 +81,7 @@ func (p *PSLanes) getDeleteLane(psID string) chan struct{} {
 		return lane
 	}
 
-	lane := make(chan struct{}, 1) // capacity=1 for serialization
+	lane := newAdaptiveLane("delete", psID)
 	p.deleteLanes[psID] = lane
 	return lane
 }
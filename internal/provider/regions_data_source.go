@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var _ datasource.DataSourceWithConfigure = &regionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{}
+}
+
+type regionsDataSource struct {
+	client *client.CloudClient
+}
+
+// regionsDataSourceModel maps the data source schema to values
+type regionsDataSourceModel struct {
+	ID        types.String  `tfsdk:"id"`
+	CloudKind types.String  `tfsdk:"cloud_kind"`
+	Regions   []regionModel `tfsdk:"regions"`
+}
+
+type regionModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	CloudKind      types.String `tfsdk:"cloud_kind"`
+	CloudAccountID types.String `tfsdk:"cloud_account_id"`
+	DNSSuffix      types.String `tfsdk:"dns_suffix"`
+}
+
+func (d *regionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *regionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the regions available for SpiceDB clusters",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for the data source",
+			},
+			"cloud_kind": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return regions of this cloud provider (AWS, GCLOUD or AZURE)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.CloudKinds...),
+				},
+			},
+			"regions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of regions",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region identifier",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the region",
+						},
+						"cloud_kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider of the region",
+						},
+						"cloud_account_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud account ID associated with the region",
+						},
+						"dns_suffix": schema.StringAttribute{
+							Computed:    true,
+							Description: "DNS suffix for clusters in the region",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *regionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data regionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, err := d.client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list regions, got error: %s", err))
+		return
+	}
+
+	// Set ID for the data source
+	if data.CloudKind.IsNull() {
+		data.ID = types.StringValue("all_regions")
+	} else {
+		data.ID = types.StringValue(fmt.Sprintf("regions_%s", data.CloudKind.ValueString()))
+	}
+
+	data.Regions = filterRegions(regions, data.CloudKind.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterRegions converts the API regions into the data source model, keeping only
+// regions of the given cloud kind when it is not empty
+func filterRegions(regions []models.Region, cloudKind string) []regionModel {
+	regionsList := []regionModel{}
+	for _, region := range regions {
+		if cloudKind != "" && region.CloudKind != cloudKind {
+			continue
+		}
+		regionsList = append(regionsList, regionModel{
+			ID:             types.StringValue(region.ID),
+			Name:           types.StringValue(region.Name),
+			CloudKind:      types.StringValue(region.CloudKind),
+			CloudAccountID: types.StringValue(region.CloudAccountID),
+			DNSSuffix:      types.StringValue(region.DNSSuffix),
+		})
+	}
+	return regionsList
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestDatastoreClusterSpecToAPI(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NilBlock", func(t *testing.T) {
+		var m *datastoreClusterSpecModel
+		spec, diags := m.toAPI(ctx)
+		if diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+		if spec != nil {
+			t.Errorf("Expected nil spec, got %+v", spec)
+		}
+	})
+
+	t.Run("Dedicated", func(t *testing.T) {
+		m := &datastoreClusterSpecModel{
+			CloudProvider: types.StringValue("AWS"),
+			Plan:          types.StringValue("ADVANCED"),
+			Labels:        types.MapNull(types.StringType),
+			Configuration: &datastoreClusterConfigurationModel{
+				DeleteProtection: types.StringValue("ENABLED"),
+				Dedicated: &datastoreDedicatedModel{
+					NumVirtualCPUs: types.Int64Value(4),
+					StorageGib:     types.Int64Value(150),
+					RegionNodes: types.MapValueMust(types.Int64Type, map[string]attr.Value{
+						"us-east-1": types.Int64Value(3),
+					}),
+				},
+			},
+			MetricExport: &datastoreMetricExportModel{
+				Datadog: &datastoreDatadogModel{
+					Enabled: types.BoolValue(true),
+					APIKey:  types.StringValue("secret"),
+					Site:    types.StringValue("US1"),
+				},
+			},
+		}
+
+		spec, diags := m.toAPI(ctx)
+		if diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+		if spec.CloudProvider != "AWS" || spec.Plan != "ADVANCED" || spec.Labels != nil {
+			t.Errorf("Unexpected spec: %+v", spec)
+		}
+		if spec.Configuration == nil || spec.Configuration.DeleteProtection == nil || *spec.Configuration.DeleteProtection != "ENABLED" {
+			t.Fatalf("Expected delete protection to be set, got %+v", spec.Configuration)
+		}
+		dedicated := spec.Configuration.Dedicated
+		if dedicated == nil || dedicated.NumVirtualCPUs != 4 || dedicated.StorageGib != 150 || dedicated.RegionNodes["us-east-1"] != 3 {
+			t.Errorf("Unexpected dedicated specification: %+v", dedicated)
+		}
+		if spec.Configuration.Serverless != nil {
+			t.Errorf("Expected no serverless specification, got %+v", spec.Configuration.Serverless)
+		}
+		if spec.MetricExport == nil || spec.MetricExport.Datadog == nil || spec.MetricExport.Datadog.APIKey != "secret" {
+			t.Errorf("Unexpected metric export: %+v", spec.MetricExport)
+		}
+	})
+}
+
+func TestDatastoreSchemaDatadogAPIKeyWriteOnly(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewDatastoreResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	cockroachDB := resp.Schema.Blocks["cockroachdb"].(schema.SingleNestedBlock)
+	metricExport := cockroachDB.Blocks["metric_export"].(schema.SingleNestedBlock)
+	datadog := metricExport.Blocks["datadog"].(schema.SingleNestedBlock)
+	apiKey, ok := datadog.Attributes["api_key"]
+	if !ok {
+		t.Fatal("Expected an api_key attribute")
+	}
+	if !apiKey.IsWriteOnly() || !apiKey.IsSensitive() {
+		t.Error("Expected api_key to be write-only and sensitive")
+	}
+	if _, ok := datadog.Attributes["api_key_version"]; !ok {
+		t.Error("Expected an api_key_version attribute")
+	}
+
+	if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+		t.Fatalf("Invalid schema implementation: %v", diags)
+	}
+}
+
+func TestSetDatastoreResourceData(t *testing.T) {
+	data := &datastoreResourceModel{
+		Template: types.StringValue("crdb-dedicated"),
+		CockroachDB: &datastoreClusterSpecModel{
+			MetricExport: &datastoreMetricExportModel{
+				Datadog: &datastoreDatadogModel{
+					Enabled:       types.BoolValue(true),
+					APIKey:        types.StringValue("should-not-be-stored"),
+					APIKeyVersion: types.StringValue("1"),
+					Site:          types.StringValue("US1"),
+				},
+			},
+		},
+	}
+
+	setDatastoreResourceData(data, &models.Datastore{ID: "dbi-123", Name: "orders", TemplateName: "crdb-dedicated"})
+
+	datadog := data.CockroachDB.MetricExport.Datadog
+	if !datadog.APIKey.IsNull() {
+		t.Errorf("Expected api_key to be null in state, got %s", datadog.APIKey)
+	}
+	if datadog.APIKeyVersion.ValueString() != "1" || datadog.Site.ValueString() != "US1" {
+		t.Errorf("Expected the other Datadog settings to be kept, got %+v", datadog)
+	}
+}
+
+func TestValidateDatastoreTemplateOverrides(t *testing.T) {
+	template := models.DatastoreTemplate{
+		Name:     "crdb-dedicated",
+		Provider: "cockroachdb",
+		Defaults: map[string]any{
+			"plan": "DEDICATED",
+			"configuration": map[string]any{
+				"dedicated": map[string]any{"numVirtualCpus": float64(4), "storageGib": float64(100)},
+			},
+		},
+		Validations: []models.DatastoreTemplateValidation{
+			{Expression: "self.configuration.dedicated.numVirtualCpus >= 4", Reason: "dedicated clusters need at least 4 vCPUs"},
+			{Expression: "self.plan == 'DEDICATED'"},
+		},
+	}
+
+	t.Run("DefaultsApply", func(t *testing.T) {
+		// Only the labels are overridden, so the defaults must satisfy the validations
+		diags := validateDatastoreTemplateOverrides(template, &models.ClusterSpec{Labels: map[string]string{"team": "authz"}})
+		if diags.HasError() || diags.WarningsCount() != 0 {
+			t.Errorf("Unexpected diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("FailingOverride", func(t *testing.T) {
+		spec := &models.ClusterSpec{
+			Plan: "SERVERLESS",
+			Configuration: &models.ClusterConfiguration{
+				Dedicated: &models.DedicatedSpecification{NumVirtualCPUs: 2},
+			},
+		}
+		diags := validateDatastoreTemplateOverrides(template, spec)
+		if len(diags.Errors()) != 2 {
+			t.Fatalf("Expected 2 errors, got %v", diags)
+		}
+		if !strings.Contains(diags.Errors()[0].Detail(), "at least 4 vCPUs") {
+			t.Errorf("Expected the template reason in the error, got %q", diags.Errors()[0].Detail())
+		}
+	})
+
+	t.Run("InvalidExpressionErrors", func(t *testing.T) {
+		broken := template
+		broken.Validations = []models.DatastoreTemplateValidation{
+			{Expression: "self.plan ==", Reason: "syntax error"},
+			{Expression: "spec.plan == 'DEDICATED'", Reason: "undeclared variable"},
+		}
+		diags := validateDatastoreTemplateOverrides(broken, nil)
+		if len(diags.Errors()) != 2 || diags.WarningsCount() != 0 {
+			t.Errorf("Expected 2 errors, got %v", diags)
+		}
+	})
+
+	t.Run("UnevaluableExpressionWarns", func(t *testing.T) {
+		broken := template
+		broken.Validations = []models.DatastoreTemplateValidation{
+			{Expression: "self.missing.field > 1", Reason: "missing key"},
+			{Expression: "self.plan", Reason: "not a bool"},
+		}
+		diags := validateDatastoreTemplateOverrides(broken, nil)
+		if diags.HasError() || diags.WarningsCount() != 2 {
+			t.Errorf("Expected 2 warnings, got %v", diags)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestFindExternalMetricsTokenHash(t *testing.T) {
+	tokens := []models.ExternalMetricsTokenListItem{
+		{ID: "em-active", Hash: "a1b2c3"},
+		{ID: "em-deleted", Hash: ""},
+	}
+
+	testCases := []struct {
+		name       string
+		tokenID    string
+		wantHash   string
+		wantExists bool
+	}{
+		{name: "Active", tokenID: "em-active", wantHash: "a1b2c3", wantExists: true},
+		{name: "EmptyHash", tokenID: "em-deleted", wantHash: "", wantExists: false},
+		{name: "Missing", tokenID: "em-missing", wantHash: "", wantExists: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hash, exists := findExternalMetricsTokenHash(tokens, tc.tokenID)
+			if hash != tc.wantHash || exists != tc.wantExists {
+				t.Errorf("Expected (%q, %v), got (%q, %v)", tc.wantHash, tc.wantExists, hash, exists)
+			}
+		})
+	}
+}
+
+func TestExternalMetricsTokenResource_ReadRotatedOutside(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"tokens": []map[string]any{{"id": "em-123", "hash": "new-hash"}}})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	providerServer, schemaResp := testConfiguredProviderServer(t, server.URL)
+	tokenSchema := schemaResp.ResourceSchemas["authzed_external_metrics_token"]
+
+	readResp, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName: "authzed_external_metrics_token",
+		CurrentState: testDynamicValue(t, tokenSchema, map[string]tftypes.Value{
+			"id":                   tftypes.NewValue(tftypes.String, "em-123"),
+			"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123"),
+			"secret":               tftypes.NewValue(tftypes.String, "old-secret"),
+			"hash":                 tftypes.NewValue(tftypes.String, "old-hash"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(readResp.Diagnostics) != 1 || readResp.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityWarning {
+		t.Fatalf("Expected a single warning, got %v", readResp.Diagnostics)
+	}
+
+	state, err := readResp.NewState.Unmarshal(tokenSchema.ValueType())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !attributes["secret"].IsNull() {
+		t.Errorf("Expected the invalid secret to be removed from state, got %v", attributes["secret"])
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// permissionsSystemChildIdentityModel is the identity of resources that belong to a permission system,
+// i.e. roles, policies and service accounts
+type permissionsSystemChildIdentityModel struct {
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	ID                  types.String `tfsdk:"id"`
+}
+
+// tokenIdentityModel is the identity of a service account token
+type tokenIdentityModel struct {
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	ServiceAccountID    types.String `tfsdk:"service_account_id"`
+	ID                  types.String `tfsdk:"id"`
+}
+
+// permissionsSystemChildIdentitySchema returns the identity schema of a resource that belongs to a permission system
+func permissionsSystemChildIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"permission_system_id": identityschema.StringAttribute{
+				Description:       "The ID of the permission system",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       idDescription,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// tokenIdentitySchema returns the identity schema of a service account token
+func tokenIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"permission_system_id": identityschema.StringAttribute{
+				Description:       "The ID of the permission system",
+				RequiredForImport: true,
+			},
+			"service_account_id": identityschema.StringAttribute{
+				Description:       "The ID of the service account",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the token",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setPermissionsSystemChildIdentity stores the identity of a resource that belongs to a permission system.
+// Identity is nil when Terraform does not support resource identity.
+func setPermissionsSystemChildIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, permissionsSystemID, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, permissionsSystemChildIdentityModel{
+		PermissionsSystemID: permissionsSystemID,
+		ID:                  id,
+	})
+}
+
+// setTokenIdentity stores the identity of a service account token.
+// Identity is nil when Terraform does not support resource identity.
+func setTokenIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, permissionsSystemID, serviceAccountID, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, tokenIdentityModel{
+		PermissionsSystemID: permissionsSystemID,
+		ServiceAccountID:    serviceAccountID,
+		ID:                  id,
+	})
+}
+
+// importPermissionsSystemChildIdentity imports a resource that belongs to a permission system from
+// the identity attribute of an import block
+func importPermissionsSystemChildIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity permissionsSystemChildIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), identity.PermissionsSystemID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+}
+
+// importTokenIdentity imports a service account token from the identity attribute of an import block
+func importTokenIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity tokenIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), identity.PermissionsSystemID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_account_id"), identity.ServiceAccountID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+}
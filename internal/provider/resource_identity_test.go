@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportResourceState_Identity(t *testing.T) {
+	ctx := context.Background()
+	providerServer, err := testAccProtoV6ProviderFactories["authzed"]()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	identityResp, err := providerServer.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil || len(identityResp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error: %v %v", err, identityResp.Diagnostics)
+	}
+
+	testCases := []struct {
+		name     string
+		typeName string
+		id       string
+		identity map[string]string
+		want     map[string]string
+	}{
+		{
+			name:     "RoleImportID",
+			typeName: "authzed_role",
+			id:       "ps-123:arl-456",
+			want:     map[string]string{"permission_system_id": "ps-123", "id": "arl-456"},
+		},
+		{
+			name:     "RoleIdentity",
+			typeName: "authzed_role",
+			identity: map[string]string{"permission_system_id": "ps-123", "id": "arl-456"},
+			want:     map[string]string{"permission_system_id": "ps-123", "id": "arl-456"},
+		},
+		{
+			name:     "PolicyIdentity",
+			typeName: "authzed_policy",
+			identity: map[string]string{"permission_system_id": "ps-123", "id": "apc-456"},
+			want:     map[string]string{"permission_system_id": "ps-123", "id": "apc-456"},
+		},
+		{
+			name:     "ServiceAccountIdentity",
+			typeName: "authzed_service_account",
+			identity: map[string]string{"permission_system_id": "ps-123", "id": "asa-456"},
+			want:     map[string]string{"permission_system_id": "ps-123", "id": "asa-456"},
+		},
+		{
+			name:     "TokenImportID",
+			typeName: "authzed_token",
+			id:       "ps-123:asa-456:atk-789",
+			want:     map[string]string{"permission_system_id": "ps-123", "service_account_id": "asa-456", "id": "atk-789"},
+		},
+		{
+			name:     "TokenIdentity",
+			typeName: "authzed_token",
+			identity: map[string]string{"permission_system_id": "ps-123", "service_account_id": "asa-456", "id": "atk-789"},
+			want:     map[string]string{"permission_system_id": "ps-123", "service_account_id": "asa-456", "id": "atk-789"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			identitySchema := identityResp.IdentitySchemas[tc.typeName]
+			if identitySchema == nil {
+				t.Fatalf("Expected an identity schema for %s", tc.typeName)
+			}
+			identityType := identitySchema.ValueType()
+
+			req := &tfprotov6.ImportResourceStateRequest{TypeName: tc.typeName, ID: tc.id}
+			if tc.identity != nil {
+				values := make(map[string]tftypes.Value, len(tc.identity))
+				for name, value := range tc.identity {
+					values[name] = tftypes.NewValue(tftypes.String, value)
+				}
+				identityData, err := tfprotov6.NewDynamicValue(identityType, tftypes.NewValue(identityType, values))
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				req.Identity = &tfprotov6.ResourceIdentityData{IdentityData: &identityData}
+			}
+
+			resp, err := providerServer.ImportResourceState(ctx, req)
+			if err != nil || len(resp.Diagnostics) > 0 {
+				t.Fatalf("Unexpected error importing: %v %v", err, resp.Diagnostics)
+			}
+			if len(resp.ImportedResources) != 1 {
+				t.Fatalf("Expected 1 imported resource, got %d", len(resp.ImportedResources))
+			}
+			imported := resp.ImportedResources[0]
+
+			state := testDecodeStrings(t, imported.State, schemaResp.ResourceSchemas[tc.typeName].ValueType())
+			identity := testDecodeStrings(t, imported.Identity.IdentityData, identityType)
+			for name, want := range tc.want {
+				if state[name] != want {
+					t.Errorf("Expected state %s %q, got %q", name, want, state[name])
+				}
+				if identity[name] != want {
+					t.Errorf("Expected identity %s %q, got %q", name, want, identity[name])
+				}
+			}
+		})
+	}
+}
+
+// testDecodeStrings decodes the known string attributes of an object
+func testDecodeStrings(t *testing.T, value *tfprotov6.DynamicValue, valueType tftypes.Type) map[string]string {
+	t.Helper()
+
+	decoded, err := value.Unmarshal(valueType)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := decoded.As(&attributes); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	strings := make(map[string]string)
+	for name, attribute := range attributes {
+		var s string
+		if attribute.Type().Is(tftypes.String) && attribute.IsKnown() && !attribute.IsNull() {
+			if err := attribute.As(&s); err == nil {
+				strings[name] = s
+			}
+		}
+	}
+	return strings
+}
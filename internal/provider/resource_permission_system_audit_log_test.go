@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestSetAuditLogResourceData(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("EmptyCollections", func(t *testing.T) {
+		data := &permissionsSystemAuditLogResourceModel{
+			PermissionsSystemID: types.StringValue("ps-123"),
+			TargetConfiguration: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			DisabledOnMethods:   types.ListValueMust(types.StringType, []attr.Value{}),
+		}
+		diags := setAuditLogResourceData(ctx, data, &models.AuditLogFeature{
+			Enabled: true,
+			Config:  &models.AuditLogFeatureConfig{TargetType: "kinesis", StreamName: "audit"},
+		})
+		if diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+		if data.TargetConfiguration.IsNull() || data.DisabledOnMethods.IsNull() {
+			t.Errorf("Expected configured empty collections to be kept, got %s and %s", data.TargetConfiguration, data.DisabledOnMethods)
+		}
+	})
+
+	t.Run("UnsetCollections", func(t *testing.T) {
+		data := &permissionsSystemAuditLogResourceModel{
+			PermissionsSystemID: types.StringValue("ps-123"),
+			TargetConfiguration: types.MapNull(types.StringType),
+			DisabledOnMethods:   types.ListNull(types.StringType),
+		}
+		setAuditLogResourceData(ctx, data, &models.AuditLogFeature{
+			Enabled: true,
+			Config:  &models.AuditLogFeatureConfig{TargetType: "kinesis", StreamName: "audit"},
+		})
+		if !data.TargetConfiguration.IsNull() || !data.DisabledOnMethods.IsNull() {
+			t.Errorf("Expected unset collections to stay null, got %s and %s", data.TargetConfiguration, data.DisabledOnMethods)
+		}
+	})
+
+	t.Run("NoConfig", func(t *testing.T) {
+		data := &permissionsSystemAuditLogResourceModel{PermissionsSystemID: types.StringValue("ps-123")}
+		setAuditLogResourceData(ctx, data, &models.AuditLogFeature{})
+		if !data.TargetType.IsNull() || !data.StreamName.IsNull() {
+			t.Errorf("Expected null target type and stream name, got %s and %s", data.TargetType, data.StreamName)
+		}
+	})
+}
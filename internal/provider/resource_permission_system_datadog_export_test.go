@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestDatadogExportSchemaAPIKeyWriteOnly(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewPermissionsSystemDatadogExportResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	apiKey, ok := resp.Schema.Attributes["api_key"]
+	if !ok {
+		t.Fatal("Expected an api_key attribute")
+	}
+	if !apiKey.IsWriteOnly() || !apiKey.IsSensitive() {
+		t.Error("Expected api_key to be write-only and sensitive")
+	}
+
+	if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+		t.Fatalf("Invalid schema implementation: %v", diags)
+	}
+}
+
+func TestSetDatadogExportResourceData(t *testing.T) {
+	data := &permissionsSystemDatadogExportResourceModel{
+		PermissionsSystemID: types.StringValue("ps-test123"),
+		Site:                types.StringValue("datadoghq.com"),
+		APIKey:              types.StringValue("should-not-be-stored"),
+	}
+
+	setDatadogExportResourceData(data, &models.DatadogExportFeature{
+		Enabled:     true,
+		DisplayName: "Datadog Export",
+		Config:      &models.DatadogExportFeatureConfig{Site: "datadoghq.eu"},
+	})
+
+	if !data.APIKey.IsNull() {
+		t.Errorf("Expected api_key to be null in state, got %s", data.APIKey)
+	}
+	if data.Site.ValueString() != "datadoghq.eu" {
+		t.Errorf("Expected site to be reconciled from the API, got %s", data.Site)
+	}
+	if data.ID.ValueString() != "ps-test123" || !data.Enabled.ValueBool() {
+		t.Errorf("Unexpected model: %+v", data)
+	}
+}
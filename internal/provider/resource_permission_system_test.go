@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestBuildPermissionsSystemUpdate(t *testing.T) {
+	current := &models.PermissionsSystem{
+		ID:         "ps-test123",
+		Name:       "orders",
+		SystemType: "development",
+		Version: models.SystemVersion{
+			CurrentVersion:    models.SpiceDBVersion{Version: "v1.40.0"},
+			IsLockedToVersion: true,
+			SelectedChannel:   "stable",
+		},
+	}
+
+	t.Run("NoChanges", func(t *testing.T) {
+		data := &permissionsSystemResourceModel{
+			Name:       types.StringValue("orders"),
+			SystemType: types.StringUnknown(),
+			Channel:    types.StringNull(),
+		}
+		if update := buildPermissionsSystemUpdate(current, data); update != nil {
+			t.Fatalf("Expected no update, got %+v", update)
+		}
+	})
+
+	t.Run("RenamePreservesVersionPin", func(t *testing.T) {
+		data := &permissionsSystemResourceModel{
+			Name:       types.StringValue("orders-prod"),
+			SystemType: types.StringValue("development"),
+			Channel:    types.StringValue("stable"),
+		}
+		update := buildPermissionsSystemUpdate(current, data)
+		if update == nil {
+			t.Fatal("Expected an update")
+		}
+		if update.Name != "orders-prod" || update.SystemType != "development" || update.Channel != "stable" {
+			t.Errorf("Unexpected update request: %+v", update)
+		}
+		if update.SelectedVersion == nil || *update.SelectedVersion != "v1.40.0" {
+			t.Errorf("Expected the version pin to be preserved, got %v", update.SelectedVersion)
+		}
+	})
+
+	t.Run("ChannelChange", func(t *testing.T) {
+		data := &permissionsSystemResourceModel{
+			Name:       types.StringUnknown(),
+			SystemType: types.StringUnknown(),
+			Channel:    types.StringValue("rapid"),
+		}
+		update := buildPermissionsSystemUpdate(current, data)
+		if update == nil || update.Channel != "rapid" || update.Name != "orders" {
+			t.Errorf("Unexpected update request: %+v", update)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+func TestBuildPermissionsSystemVersionUpdate(t *testing.T) {
+	current := &models.PermissionsSystem{
+		ID:         "ps-test123",
+		Name:       "orders",
+		SystemType: "production",
+		Version: models.SystemVersion{
+			CurrentVersion:  models.SpiceDBVersion{Version: "v1.40.0"},
+			SelectedChannel: "stable",
+		},
+	}
+
+	t.Run("Pin", func(t *testing.T) {
+		data := &permissionsSystemVersionResourceModel{
+			Version: types.StringValue("v1.41.0"),
+			Channel: types.StringUnknown(),
+		}
+		update := buildPermissionsSystemVersionUpdate(current, data)
+		if update == nil || update.SelectedVersion == nil || *update.SelectedVersion != "v1.41.0" {
+			t.Fatalf("Expected a pin to v1.41.0, got %+v", update)
+		}
+		if update.Name != "orders" || update.SystemType != "production" || update.Channel != "stable" {
+			t.Errorf("Expected unmanaged settings to be preserved, got %+v", update)
+		}
+	})
+
+	t.Run("AlreadyOnChannel", func(t *testing.T) {
+		data := &permissionsSystemVersionResourceModel{
+			Version: types.StringNull(),
+			Channel: types.StringValue("stable"),
+		}
+		if update := buildPermissionsSystemVersionUpdate(current, data); update != nil {
+			t.Errorf("Expected no update, got %+v", update)
+		}
+	})
+
+	t.Run("UnpinToChannel", func(t *testing.T) {
+		pinned := *current
+		pinned.Version.IsLockedToVersion = true
+		data := &permissionsSystemVersionResourceModel{
+			Version: types.StringNull(),
+			Channel: types.StringValue("rapid"),
+		}
+		update := buildPermissionsSystemVersionUpdate(&pinned, data)
+		if update == nil || update.SelectedVersion != nil || update.Channel != "rapid" {
+			t.Errorf("Expected an unpinned move to rapid, got %+v", update)
+		}
+	})
+}
+
+func TestWaitForPermissionSystemRunning(t *testing.T) {
+	originalInterval := permissionSystemPollInterval
+	permissionSystemPollInterval = 10 * time.Millisecond
+	defer func() { permissionSystemPollInterval = originalInterval }()
+
+	// Each state is a status, optionally followed by the current version, which defaults to v1.41.0
+	newClient := func(states ...string) *client.CloudClient {
+		polls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			state, version, found := strings.Cut(states[min(polls, len(states)-1)], " ")
+			if !found {
+				version = "v1.41.0"
+			}
+			polls++
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"id": "ps-test123", "systemState": {"status": %q, "message": "migration failed"}, "version": {"currentVersion": {"version": %q}}}`, state, version)
+		}))
+		t.Cleanup(server.Close)
+		return client.NewCloudClient(&client.CloudClientConfig{
+			Host:       server.URL,
+			Token:      "test-token",
+			APIVersion: "v1",
+			Timeout:    client.DefaultTimeout,
+		})
+	}
+
+	t.Run("Running", func(t *testing.T) {
+		c := newClient("UPGRADING", "MODIFYING", "RUNNING")
+		ps, err := waitForPermissionSystemRunning(context.Background(), c, "ps-test123", permissionSystemUpgrade{TargetVersion: "v1.41.0"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if ps.PermissionsSystem.SystemState.Status != "RUNNING" {
+			t.Errorf("Expected RUNNING, got %s", ps.PermissionsSystem.SystemState.Status)
+		}
+	})
+
+	t.Run("ChannelUpgrade", func(t *testing.T) {
+		// The first poll still sees the previous version running, before the upgrade starts
+		c := newClient("RUNNING v1.40.0", "UPGRADING v1.40.0", "RUNNING v1.41.0")
+		ps, err := waitForPermissionSystemRunning(context.Background(), c, "ps-test123", permissionSystemUpgrade{
+			PreviousVersion: "v1.40.0",
+			Expected:        true,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if version := ps.PermissionsSystem.Version.CurrentVersion.Version; version != "v1.41.0" {
+			t.Errorf("Expected the upgraded version v1.41.0, got %s", version)
+		}
+	})
+
+	t.Run("ChannelUpgradeNotStarted", func(t *testing.T) {
+		originalGracePeriod := permissionSystemUpgradeStartGracePeriod
+		permissionSystemUpgradeStartGracePeriod = 30 * time.Millisecond
+		defer func() { permissionSystemUpgradeStartGracePeriod = originalGracePeriod }()
+
+		c := newClient("RUNNING v1.40.0")
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		ps, err := waitForPermissionSystemRunning(ctx, c, "ps-test123", permissionSystemUpgrade{
+			PreviousVersion: "v1.40.0",
+			Expected:        true,
+		})
+		if err != nil {
+			t.Fatalf("Expected RUNNING to be accepted after the grace period, got %v", err)
+		}
+		if version := ps.PermissionsSystem.Version.CurrentVersion.Version; version != "v1.40.0" {
+			t.Errorf("Expected the unchanged version v1.40.0, got %s", version)
+		}
+	})
+
+	t.Run("ChannelWithoutUpgrade", func(t *testing.T) {
+		c := newClient("RUNNING v1.40.0", "UPGRADING v1.40.0", "RUNNING v1.41.0")
+		ps, err := waitForPermissionSystemRunning(context.Background(), c, "ps-test123", permissionSystemUpgrade{
+			PreviousVersion: "v1.40.0",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if version := ps.PermissionsSystem.Version.CurrentVersion.Version; version != "v1.40.0" {
+			t.Errorf("Expected the unchanged version v1.40.0, got %s", version)
+		}
+	})
+
+	t.Run("UpgradeError", func(t *testing.T) {
+		c := newClient("UPGRADING", "UPGRADE_ERROR")
+		_, err := waitForPermissionSystemRunning(context.Background(), c, "ps-test123", permissionSystemUpgrade{TargetVersion: "v1.41.0"})
+		upgradeErr := &permissionSystemUpgradeError{}
+		if !errors.As(err, &upgradeErr) || upgradeErr.Message != "migration failed" {
+			t.Fatalf("Expected an upgrade error, got %v", err)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		c := newClient("UPGRADING")
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if _, err := waitForPermissionSystemRunning(ctx, c, "ps-test123", permissionSystemUpgrade{TargetVersion: "v1.41.0"}); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected a deadline error, got %v", err)
+		}
+	})
+}
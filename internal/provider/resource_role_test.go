@@ -3,7 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -266,3 +272,84 @@ func testAccRoleImportStateIdFunc(resourceName string) resource.ImportStateIdFun
 		return fmt.Sprintf("%s:%s", permissionSystemID, roleID), nil
 	}
 }
+
+func TestUnsupportedPermissionKeys(t *testing.T) {
+	keys := []string{"authzed.v1/CheckPermission", "authzed.v1/ExperimentalReflectSchema", "authzed.v1/ReadSchema"}
+
+	testCases := []struct {
+		name          string
+		supportedAPIs []string
+		want          []string
+	}{
+		{name: "NotListed", supportedAPIs: nil, want: nil},
+		{
+			name:          "FullKeys",
+			supportedAPIs: []string{"authzed.v1/CheckPermission", "authzed.v1/ReadSchema"},
+			want:          []string{"authzed.v1/ExperimentalReflectSchema"},
+		},
+		{
+			name:          "QualifiedMethods",
+			supportedAPIs: []string{"authzed.api.v1.PermissionsService.CheckPermission", "authzed.api.v1.SchemaService.ReadSchema"},
+			want:          []string{"authzed.v1/ExperimentalReflectSchema"},
+		},
+		{
+			name:          "AllSupported",
+			supportedAPIs: []string{"CheckPermission", "ExperimentalReflectSchema", "ReadSchema"},
+			want:          nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := unsupportedPermissionKeys(keys, tc.supportedAPIs); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSupportedAPIsCache(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		// Keep the request in flight long enough for concurrent reads to share it
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"id": "ps-test123", "version": {"currentVersion": {"version": "v1.41.0", "supportedAPIs": ["authzed.v1/CheckPermission"]}}}`)
+	}))
+	defer server.Close()
+
+	c := client.NewCloudClient(&client.CloudClientConfig{
+		Host:       server.URL,
+		Token:      "test-token",
+		APIVersion: "v1",
+		Timeout:    client.DefaultTimeout,
+	})
+
+	cache := newSupportedAPIsCache()
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			versionAPIs, err := cache.get(context.Background(), c, "ps-test123")
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			if versionAPIs.Version != "v1.41.0" || len(versionAPIs.SupportedAPIs) != 1 {
+				t.Errorf("Unexpected version: %+v", versionAPIs)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Later reads are served from the cache
+	if _, err := cache.get(context.Background(), c, "ps-test123"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected the permission system to be read once, got %d requests", n)
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/provider/concurrency"
+	"terraform-provider-authzed/internal/provider/pslanes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &revokeServiceAccountTokensAction{}
+	_ action.ActionWithConfigure = &revokeServiceAccountTokensAction{}
+)
+
+func NewRevokeServiceAccountTokensAction() action.Action {
+	return &revokeServiceAccountTokensAction{}
+}
+
+// revokeServiceAccountTokensAction deletes every token of a service account
+type revokeServiceAccountTokensAction struct {
+	client  *client.CloudClient
+	psLanes *pslanes.PSLanes
+	limits  *concurrency.Limits
+}
+
+type revokeServiceAccountTokensActionModel struct {
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	ServiceAccountID    types.String `tfsdk:"service_account_id"`
+}
+
+func (a *revokeServiceAccountTokensAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_revoke_service_account_tokens"
+}
+
+func (a *revokeServiceAccountTokensAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deletes every token of a service account.",
+		Attributes: map[string]schema.Attribute{
+			"permission_system_id": schema.StringAttribute{
+				Description: "The ID of the permission system",
+				Required:    true,
+			},
+			"service_account_id": schema.StringAttribute{
+				Description: "The ID of the service account whose tokens are revoked",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *revokeServiceAccountTokensAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = providerData.Client
+	a.psLanes = providerData.PSLanes
+	a.limits = providerData.Limits
+}
+
+// Invoke deletes the tokens one by one, and keeps going when a deletion fails so that as many
+// tokens as possible are revoked
+func (a *revokeServiceAccountTokensAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data revokeServiceAccountTokensActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionSystemID := data.PermissionsSystemID.ValueString()
+	serviceAccountID := data.ServiceAccountID.ValueString()
+
+	tokens, err := a.client.ListTokens(permissionSystemID, serviceAccountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tokens, got error: %s", err))
+		return
+	}
+
+	revoked := 0
+	for _, token := range tokens {
+		// Delete the token in the adaptive delete lane of its Permission System, with 409 retry
+		err := a.limits.With(ctx, concurrency.Tokens, func() error {
+			return a.psLanes.WithDeleteLane(ctx, permissionSystemID, func() error {
+				return pslanes.Retry409Delete(ctx, func() error {
+					return a.client.DeleteToken(permissionSystemID, serviceAccountID, token.ID)
+				})
+			})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete token %s (%s), got error: %s", token.ID, token.Name, err))
+			continue
+		}
+		revoked++
+		sendActionProgress(resp, fmt.Sprintf("Deleted token %s (%s)", token.ID, token.Name))
+	}
+
+	sendActionProgress(resp, fmt.Sprintf("Revoked %d of %d tokens of service account %s", revoked, len(tokens), serviceAccountID))
+}
@@ -37,7 +37,7 @@ func (d *roleDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_role"
 }
 
-func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+func (d *roleDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Fetches a role by ID",
 		Attributes: map[string]schema.Attribute{
@@ -62,9 +62,10 @@ func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "ID of the permission system this role belongs to",
 			},
 			"permissions": schema.MapAttribute{
-				Computed:    true,
-				Description: "Map of permission name to expression",
-				ElementType: types.StringType,
+				Computed:            true,
+				Description:         "Map of permission name to expression; " + validPermissionKeys().Description(ctx),
+				MarkdownDescription: "Map of permission name to expression; " + validPermissionKeys().MarkdownDescription(ctx),
+				ElementType:         types.StringType,
 			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
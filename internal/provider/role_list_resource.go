@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &roleResource{}
+	_ list.ListResourceWithConfigure = &roleResource{}
+)
+
+func NewRoleListResource() list.ListResource {
+	return &roleResource{}
+}
+
+func (r *roleResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = permissionsSystemListConfigSchema("Lists the roles of a permission system")
+}
+
+// List streams the roles of a permission system
+func (r *roleResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config permissionsSystemListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	roles, err := r.client.ListRoles(config.PermissionsSystemID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, roles, func(role models.Role, result *list.ListResult) {
+		result.DisplayName = role.Name
+		result.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, result.Identity, config.PermissionsSystemID, types.StringValue(role.ID))...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(r.listResultResource(ctx, config.PermissionsSystemID, role, result)...)
+		}
+	})
+}
+
+// listResultResource sets the resource of a list result from a listed role
+func (r *roleResource) listResultResource(ctx context.Context, permissionsSystemID types.String, role models.Role, result *list.ListResult) diag.Diagnostics {
+	data := roleResourceModel{
+		ID:                  types.StringValue(role.ID),
+		Name:                types.StringValue(role.Name),
+		Description:         types.StringValue(role.Description),
+		PermissionsSystemID: permissionsSystemID,
+		CreatedAt:           types.StringValue(role.CreatedAt),
+		Creator:             types.StringValue(role.Creator),
+		UpdatedAt:           stringValueOrNull(role.UpdatedAt),
+		Updater:             stringValueOrNull(role.Updater),
+		// The list response carries no ETag, the next refresh stores it
+		ETag: types.StringNull(),
+	}
+
+	rolePermissions := make(map[string]string, len(role.Permissions))
+	for k, v := range role.Permissions {
+		rolePermissions[k] = v
+	}
+	permissions, diags := types.MapValueFrom(ctx, types.StringType, rolePermissions)
+	if diags.HasError() {
+		return diags
+	}
+	data.Permissions = permissions
+
+	diags.Append(result.Resource.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(result.Resource.Set(ctx, &data)...)
+	return diags
+}
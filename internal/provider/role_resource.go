@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,16 +13,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-authzed/internal/client"
 	"terraform-provider-authzed/internal/models"
+	"terraform-provider-authzed/internal/provider/concurrency"
 	"terraform-provider-authzed/internal/provider/pslanes"
 )
 
 var (
 	_ resource.Resource                = &roleResource{}
 	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.ResourceWithIdentity    = &roleResource{}
+	_ resource.ResourceWithModifyPlan  = &roleResource{}
 )
 
 func NewRoleResource() resource.Resource {
@@ -29,8 +35,11 @@ func NewRoleResource() resource.Resource {
 }
 
 type roleResource struct {
-	client  *client.CloudClient
-	psLanes *pslanes.PSLanes
+	client                 *client.CloudClient
+	psLanes                *pslanes.PSLanes
+	limits                 *concurrency.Limits
+	supportedAPIs          *supportedAPIsCache
+	unsupportedPermissions string
 }
 
 type roleResourceModel struct {
@@ -76,8 +85,12 @@ func (r *roleResource) Schema(ctx context.Context, _ resource.SchemaRequest, res
 			},
 			"permissions": schema.MapAttribute{
 				Required:    true,
-				Description: "Map of permission name to expression",
+				Description: "Map of permission name (e.g., authzed.v1/CheckPermission) to expression",
 				ElementType: types.StringType,
+				Validators: []validator.Map{
+					validPermissionKeys(),
+					validPermissionExpressions(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
@@ -131,6 +144,58 @@ func (r *roleResource) Configure(_ context.Context, req resource.ConfigureReques
 
 	r.client = providerData.Client
 	r.psLanes = providerData.PSLanes
+	r.limits = providerData.Limits
+	r.supportedAPIs = providerData.SupportedAPIs
+	r.unsupportedPermissions = providerData.UnsupportedPermissions
+}
+
+// ModifyPlan reports permissions that the SpiceDB version of the permission system does not support,
+// since the role would not grant them
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or when the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil || r.supportedAPIs == nil {
+		return
+	}
+
+	var plan roleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PermissionsSystemID.IsUnknown() || plan.Permissions.IsNull() || plan.Permissions.IsUnknown() {
+		return
+	}
+
+	versionAPIs, err := r.supportedAPIs.get(ctx, r.client, plan.PermissionsSystemID.ValueString())
+	if err != nil {
+		// The API validates the role on apply, so don't block the plan
+		tflog.Debug(ctx, "Unable to read permission system version, skipping supported API check", map[string]any{
+			"permission_system_id": plan.PermissionsSystemID.ValueString(),
+			"error":                err.Error(),
+		})
+		return
+	}
+
+	keys := make([]string, 0, len(plan.Permissions.Elements()))
+	for key := range plan.Permissions.Elements() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range unsupportedPermissionKeys(keys, versionAPIs.SupportedAPIs) {
+		summary := "Permission Not Supported by SpiceDB Version"
+		detail := fmt.Sprintf(
+			"Permission system %s runs SpiceDB %s, which does not support %q, so the role will not grant it.",
+			plan.PermissionsSystemID.ValueString(), versionAPIs.Version, key,
+		)
+		attributePath := path.Root("permissions").AtMapKey(key)
+		if r.unsupportedPermissions == UnsupportedPermissionsError {
+			resp.Diagnostics.AddAttributeError(attributePath, summary, detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(attributePath, summary, detail)
+		}
+	}
 }
 
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -174,12 +239,14 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Permissions:         permissionsMap,
 	}
 
-	// Serialize role creation per Permission System to prevent FGAM conflicts
+	// Create the role in the adaptive create lane of its Permission System, which narrows on FGAM conflicts
 	var createdRoleWithETag *client.RoleWithETag
-	err := r.psLanes.WithCreateLane(createCtx, role.PermissionsSystemID, func() error {
-		var createErr error
-		createdRoleWithETag, createErr = r.client.CreateRole(createCtx, role)
-		return createErr
+	err := r.limits.With(createCtx, concurrency.Roles, func() error {
+		return r.psLanes.WithCreateLane(createCtx, role.PermissionsSystemID, func() error {
+			var createErr error
+			createdRoleWithETag, createErr = r.client.CreateRole(createCtx, role)
+			return createErr
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role, got error: %s", err))
@@ -202,6 +269,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	data.ETag = types.StringValue(createdRoleWithETag.ETag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 // Read reads the role state
@@ -255,6 +323,7 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Permissions = permissionsValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -290,7 +359,12 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Use the ETag from state for optimistic concurrency control
-	updatedRoleWithETag, err := r.client.UpdateRole(ctx, role, state.ETag.ValueString())
+	var updatedRoleWithETag *client.RoleWithETag
+	err := r.limits.With(ctx, concurrency.Roles, func() error {
+		var updateErr error
+		updatedRoleWithETag, updateErr = r.client.UpdateRole(ctx, role, state.ETag.ValueString())
+		return updateErr
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role, got error: %s", err))
 		return
@@ -313,6 +387,7 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	data.ETag = types.StringValue(updatedRoleWithETag.ETag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -335,10 +410,12 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	permissionSystemID := data.PermissionsSystemID.ValueString()
 
-	// Serialize role deletion per Permission System with 409 retry
-	err := r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
-		return pslanes.Retry409Delete(deleteCtx, func() error {
-			return r.client.DeleteRole(permissionSystemID, data.ID.ValueString())
+	// Delete the role in the adaptive delete lane of its Permission System, with 409 retry
+	err := r.limits.With(deleteCtx, concurrency.Roles, func() error {
+		return r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
+			return pslanes.Retry409Delete(deleteCtx, func() error {
+				return r.client.DeleteRole(permissionSystemID, data.ID.ValueString())
+			})
 		})
 	})
 	if err != nil {
@@ -347,8 +424,19 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// IdentitySchema defines the identity of the role, which import blocks can use instead of an import ID
+func (r *roleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = permissionsSystemChildIdentitySchema("The ID of the role")
+}
+
 // ImportState handles importing an existing role into Terraform state
 func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import blocks may identify the resource with an identity instead of an import ID
+	if req.ID == "" {
+		importPermissionsSystemChildIdentity(ctx, req, resp)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ":")
 	if len(idParts) != 2 {
 		resp.Diagnostics.AddError(
@@ -364,6 +452,7 @@ func (r *roleResource) ImportState(ctx context.Context, req resource.ImportState
 	// Set the main identifiers
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), permissionSystemID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleID)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, types.StringValue(permissionSystemID), types.StringValue(roleID))...)
 
 	// Terraform automatically calls Read to fetch the rest of the attributes
 }
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+	"terraform-provider-authzed/internal/provider/concurrency"
+	"terraform-provider-authzed/internal/provider/pslanes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &rotateTokenAction{}
+	_ action.ActionWithConfigure = &rotateTokenAction{}
+)
+
+func NewRotateTokenAction() action.Action {
+	return &rotateTokenAction{}
+}
+
+// rotateTokenAction replaces a token with a new one on the same service account
+type rotateTokenAction struct {
+	client  *client.CloudClient
+	psLanes *pslanes.PSLanes
+	limits  *concurrency.Limits
+}
+
+type rotateTokenActionModel struct {
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	ServiceAccountID    types.String `tfsdk:"service_account_id"`
+	TokenID             types.String `tfsdk:"token_id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+}
+
+func (a *rotateTokenAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rotate_token"
+}
+
+func (a *rotateTokenAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a replacement for a token on the same service account and deletes the old token.",
+		Attributes: map[string]schema.Attribute{
+			"permission_system_id": schema.StringAttribute{
+				Description: "The ID of the permission system",
+				Required:    true,
+			},
+			"service_account_id": schema.StringAttribute{
+				Description: "The ID of the service account of the token",
+				Required:    true,
+			},
+			"token_id": schema.StringAttribute{
+				Description: "The ID of the token to rotate",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the replacement token. Defaults to the name of the rotated token",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the replacement token. Defaults to the description of the rotated token",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *rotateTokenAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = providerData.Client
+	a.psLanes = providerData.PSLanes
+	a.limits = providerData.Limits
+}
+
+// Invoke creates the replacement token before deleting the old one, so that the service account
+// never ends up without a token if the creation fails
+func (a *rotateTokenAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data rotateTokenActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionSystemID := data.PermissionsSystemID.ValueString()
+	serviceAccountID := data.ServiceAccountID.ValueString()
+	tokenID := data.TokenID.ValueString()
+
+	oldTokenWithETag, err := a.client.GetToken(ctx, permissionSystemID, serviceAccountID, tokenID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read token %s, got error: %s", tokenID, err))
+		return
+	}
+
+	token := &models.TokenRequest{
+		Name:                oldTokenWithETag.Token.Name,
+		Description:         oldTokenWithETag.Token.Description,
+		PermissionsSystemID: permissionSystemID,
+		ServiceAccountID:    serviceAccountID,
+	}
+	if !data.Name.IsNull() {
+		token.Name = data.Name.ValueString()
+	}
+	if !data.Description.IsNull() {
+		token.Description = data.Description.ValueString()
+	}
+
+	// Create the token in the adaptive create lane of its Permission System, which narrows on FGAM conflicts
+	var createdTokenWithETag *client.TokenWithETag
+	err = a.limits.With(ctx, concurrency.Tokens, func() error {
+		return a.psLanes.WithCreateLane(ctx, permissionSystemID, func() error {
+			ct, cerr := a.client.CreateToken(ctx, token)
+			if cerr != nil {
+				return cerr
+			}
+			createdTokenWithETag = ct
+			return nil
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create replacement token, got error: %s", err))
+		return
+	}
+	// Creates that time out are recovered by looking up a token with the same name, which may be the rotated token
+	if createdTokenWithETag.Token.ID == tokenID {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to confirm the creation of a replacement token, token %s was not deleted", tokenID),
+		)
+		return
+	}
+	sendActionProgress(resp, fmt.Sprintf("Created replacement token %s (%s)", createdTokenWithETag.Token.ID, createdTokenWithETag.Token.Name))
+
+	// Delete the token in the adaptive delete lane of its Permission System, with 409 retry
+	err = a.limits.With(ctx, concurrency.Tokens, func() error {
+		return a.psLanes.WithDeleteLane(ctx, permissionSystemID, func() error {
+			return pslanes.Retry409Delete(ctx, func() error {
+				return a.client.DeleteToken(permissionSystemID, serviceAccountID, tokenID)
+			})
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Created replacement token %s but unable to delete token %s, got error: %s", createdTokenWithETag.Token.ID, tokenID, err),
+		)
+		return
+	}
+	sendActionProgress(resp, fmt.Sprintf("Deleted token %s", tokenID))
+}
+
+// sendActionProgress reports the progress of an action to Terraform
+func sendActionProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
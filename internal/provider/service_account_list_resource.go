@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &serviceAccountResource{}
+	_ list.ListResourceWithConfigure = &serviceAccountResource{}
+)
+
+func NewServiceAccountListResource() list.ListResource {
+	return &serviceAccountResource{}
+}
+
+func (r *serviceAccountResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = permissionsSystemListConfigSchema("Lists the service accounts of a permission system")
+}
+
+// List streams the service accounts of a permission system
+func (r *serviceAccountResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config permissionsSystemListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	serviceAccounts, err := r.client.ListServiceAccounts(config.PermissionsSystemID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list service accounts, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, serviceAccounts, func(serviceAccount models.ServiceAccount, result *list.ListResult) {
+		result.DisplayName = serviceAccount.Name
+		result.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, result.Identity, config.PermissionsSystemID, types.StringValue(serviceAccount.ID))...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(r.listResultResource(ctx, config.PermissionsSystemID, serviceAccount, result)...)
+		}
+	})
+}
+
+// listResultResource sets the resource of a list result from a listed service account
+func (r *serviceAccountResource) listResultResource(ctx context.Context, permissionsSystemID types.String, serviceAccount models.ServiceAccount, result *list.ListResult) diag.Diagnostics {
+	data := serviceAccountResourceModel{
+		ID:                  types.StringValue(serviceAccount.ID),
+		Name:                types.StringValue(serviceAccount.Name),
+		Description:         types.StringValue(serviceAccount.Description),
+		PermissionsSystemID: permissionsSystemID,
+		CreatedAt:           types.StringValue(serviceAccount.CreatedAt),
+		Creator:             types.StringValue(serviceAccount.Creator),
+		UpdatedAt:           stringValueOrNull(serviceAccount.UpdatedAt),
+		Updater:             stringValueOrNull(serviceAccount.Updater),
+		// The list response carries no ETag, the next refresh stores it
+		ETag: types.StringNull(),
+	}
+
+	diags := result.Resource.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(result.Resource.Set(ctx, &data)...)
+	return diags
+}
@@ -17,12 +17,14 @@ import (
 
 	"terraform-provider-authzed/internal/client"
 	"terraform-provider-authzed/internal/models"
+	"terraform-provider-authzed/internal/provider/concurrency"
 	"terraform-provider-authzed/internal/provider/pslanes"
 )
 
 var (
 	_ resource.Resource                = &serviceAccountResource{}
 	_ resource.ResourceWithImportState = &serviceAccountResource{}
+	_ resource.ResourceWithIdentity    = &serviceAccountResource{}
 )
 
 func NewServiceAccountResource() resource.Resource {
@@ -32,6 +34,7 @@ func NewServiceAccountResource() resource.Resource {
 type serviceAccountResource struct {
 	client  *client.CloudClient
 	psLanes *pslanes.PSLanes
+	limits  *concurrency.Limits
 }
 
 type serviceAccountResourceModel struct {
@@ -131,6 +134,7 @@ func (r *serviceAccountResource) Configure(_ context.Context, req resource.Confi
 
 	r.client = providerData.Client
 	r.psLanes = providerData.PSLanes
+	r.limits = providerData.Limits
 }
 
 func (r *serviceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -158,7 +162,12 @@ func (r *serviceAccountResource) Create(ctx context.Context, req resource.Create
 		PermissionsSystemID: data.PermissionsSystemID.ValueString(),
 	}
 
-	createdServiceAccountWithETag, err := r.client.CreateServiceAccount(createCtx, serviceAccount)
+	var createdServiceAccountWithETag *client.ServiceAccountWithETag
+	err := r.limits.With(createCtx, concurrency.ServiceAccounts, func() error {
+		var createErr error
+		createdServiceAccountWithETag, createErr = r.client.CreateServiceAccount(createCtx, serviceAccount)
+		return createErr
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
 		return
@@ -189,6 +198,7 @@ func (r *serviceAccountResource) Create(ctx context.Context, req resource.Create
 		data.ETag = types.StringValue(createdServiceAccountWithETag.ETag)
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 		return
 	}
 
@@ -209,6 +219,7 @@ func (r *serviceAccountResource) Create(ctx context.Context, req resource.Create
 	data.ETag = types.StringValue(createdServiceAccountWithETag.ETag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -249,6 +260,7 @@ func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 	data.ETag = types.StringValue(serviceAccountWithETag.ETag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *serviceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -280,7 +292,15 @@ func (r *serviceAccountResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Use the ETag from state for optimistic concurrency control
-	updateResult := r.client.UpdateServiceAccount(ctx, serviceAccount, state.ETag.ValueString())
+	var updateResult *client.ServiceAccountUpdateResult
+	err := r.limits.With(ctx, concurrency.ServiceAccounts, func() error {
+		updateResult = r.client.UpdateServiceAccount(ctx, serviceAccount, state.ETag.ValueString())
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service account, got error: %s", err))
+		return
+	}
 	resp.Diagnostics.Append(updateResult.Diagnostics...)
 
 	if updateResult.ServiceAccount == nil {
@@ -312,6 +332,7 @@ func (r *serviceAccountResource) Update(ctx context.Context, req resource.Update
 	data.PermissionsSystemID = types.StringValue(updatedServiceAccountWithETag.ServiceAccount.PermissionsSystemID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, data.PermissionsSystemID, data.ID)...)
 }
 
 func (r *serviceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -334,10 +355,12 @@ func (r *serviceAccountResource) Delete(ctx context.Context, req resource.Delete
 
 	permissionSystemID := data.PermissionsSystemID.ValueString()
 
-	// Serialize service account deletion per Permission System with 409 retry
-	err := r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
-		return pslanes.Retry409Delete(deleteCtx, func() error {
-			return r.client.DeleteServiceAccount(permissionSystemID, data.ID.ValueString())
+	// Delete the service account in the adaptive delete lane of its Permission System, with 409 retry
+	err := r.limits.With(deleteCtx, concurrency.ServiceAccounts, func() error {
+		return r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
+			return pslanes.Retry409Delete(deleteCtx, func() error {
+				return r.client.DeleteServiceAccount(permissionSystemID, data.ID.ValueString())
+			})
 		})
 	})
 	if err != nil {
@@ -346,8 +369,19 @@ func (r *serviceAccountResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+// IdentitySchema defines the identity of the service account, which import blocks can use instead of an import ID
+func (r *serviceAccountResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = permissionsSystemChildIdentitySchema("The ID of the service account")
+}
+
 // ImportState handles importing an existing service account into Terraform state
 func (r *serviceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import blocks may identify the resource with an identity instead of an import ID
+	if req.ID == "" {
+		importPermissionsSystemChildIdentity(ctx, req, resp)
+		return
+	}
+
 	// Import ID format: permission_system_id:service_account_id
 	idParts := strings.Split(req.ID, ":")
 	if len(idParts) != 2 {
@@ -364,6 +398,7 @@ func (r *serviceAccountResource) ImportState(ctx context.Context, req resource.I
 	// Set the main identifiers
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), permissionSystemID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), serviceAccountID)...)
+	resp.Diagnostics.Append(setPermissionsSystemChildIdentity(ctx, resp.Identity, types.StringValue(permissionSystemID), types.StringValue(serviceAccountID))...)
 
 	// Terraform automatically calls Read to fetch the rest of the attributes
 }
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+)
+
+var _ datasource.DataSourceWithConfigure = &spiceDBChannelsDataSource{}
+
+func NewSpiceDBChannelsDataSource() datasource.DataSource {
+	return &spiceDBChannelsDataSource{}
+}
+
+type spiceDBChannelsDataSource struct {
+	client *client.CloudClient
+}
+
+// spiceDBChannelsDataSourceModel maps the data source schema to values
+type spiceDBChannelsDataSourceModel struct {
+	ID            types.String          `tfsdk:"id"`
+	DataStoreKind types.String          `tfsdk:"datastore_kind"`
+	Channels      []spiceDBChannelModel `tfsdk:"channels"`
+}
+
+type spiceDBChannelModel struct {
+	Name          types.String          `tfsdk:"name"`
+	DisplayName   types.String          `tfsdk:"display_name"`
+	Description   types.String          `tfsdk:"description"`
+	LatestVersion types.String          `tfsdk:"latest_version"`
+	Versions      []spiceDBVersionModel `tfsdk:"versions"`
+}
+
+type spiceDBVersionModel struct {
+	Version               types.String `tfsdk:"version"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	SupportedAPIs         types.List   `tfsdk:"supported_apis"`
+	SupportedFeatureNames types.List   `tfsdk:"supported_feature_names"`
+}
+
+func (d *spiceDBChannelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spicedb_channels"
+}
+
+func (d *spiceDBChannelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the SpiceDB update channels and the versions available in each",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier for the data source",
+			},
+			"datastore_kind": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return channels and versions for this kind of datastore (cockroachdb, postgres or spanner)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.DatastoreTypes...),
+				},
+			},
+			"channels": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of update channels",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique name of the channel (e.g., stable)",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the channel",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the channel",
+						},
+						"latest_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Newest version available in the channel",
+						},
+						"versions": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Versions available in the channel, sorted from newest to oldest",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: spiceDBVersionAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// spiceDBVersionAttributes returns the schema attributes describing a SpiceDB version
+func spiceDBVersionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"version": schema.StringAttribute{
+			Computed:    true,
+			Description: "Version of SpiceDB",
+		},
+		"display_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name of the version",
+		},
+		"supported_apis": schema.ListAttribute{
+			Computed:    true,
+			Description: "SpiceDB APIs supported by the version",
+			ElementType: types.StringType,
+		},
+		"supported_feature_names": schema.ListAttribute{
+			Computed:    true,
+			Description: "Features supported by the version",
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (d *spiceDBChannelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *spiceDBChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data spiceDBChannelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, err := d.client.ListChannelsAndVersions(ctx, data.DataStoreKind.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list SpiceDB channels, got error: %s", err))
+		return
+	}
+
+	// Set ID for the data source
+	if data.DataStoreKind.IsNull() {
+		data.ID = types.StringValue("all_channels")
+	} else {
+		data.ID = types.StringValue(fmt.Sprintf("channels_%s", data.DataStoreKind.ValueString()))
+	}
+
+	channelList := make([]spiceDBChannelModel, 0, len(channels))
+	for _, channel := range channels {
+		channelModel := spiceDBChannelModel{
+			Name:          types.StringValue(channel.Name),
+			DisplayName:   types.StringValue(channel.DisplayName),
+			Description:   types.StringValue(channel.Description),
+			LatestVersion: types.StringNull(),
+			Versions:      make([]spiceDBVersionModel, 0, len(channel.Versions)),
+		}
+
+		// Versions are sorted from newest to oldest
+		if len(channel.Versions) > 0 {
+			channelModel.LatestVersion = types.StringValue(channel.Versions[0].Version)
+		}
+
+		for _, version := range channel.Versions {
+			versionModel, diags := newSpiceDBVersionModel(ctx, version)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			channelModel.Versions = append(channelModel.Versions, versionModel)
+		}
+
+		channelList = append(channelList, channelModel)
+	}
+
+	data.Channels = channelList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newSpiceDBVersionModel maps an API SpiceDB version onto the data source model
+func newSpiceDBVersionModel(ctx context.Context, version models.SpiceDBVersion) (spiceDBVersionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	supportedAPIs, listDiags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(version.SupportedAPIs))
+	diags.Append(listDiags...)
+
+	supportedFeatureNames, listDiags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(version.SupportedFeatureNames))
+	diags.Append(listDiags...)
+
+	return spiceDBVersionModel{
+		Version:               types.StringValue(version.Version),
+		DisplayName:           types.StringValue(version.DisplayName),
+		SupportedAPIs:         supportedAPIs,
+		SupportedFeatureNames: supportedFeatureNames,
+	}, diags
+}
+
+// nonNilStrings returns an empty slice instead of nil, so that lists are empty rather than null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+
+	"terraform-provider-authzed/internal/client"
+)
+
+// Severities of the diagnostics reported for role permissions the SpiceDB version does not support
+const (
+	UnsupportedPermissionsWarning = "warning"
+	UnsupportedPermissionsError   = "error"
+)
+
+// spiceDBVersionAPIs is the SpiceDB version of a permission system and the APIs it supports
+type spiceDBVersionAPIs struct {
+	Version       string
+	SupportedAPIs []string
+}
+
+// supportedAPIsCache caches the SpiceDB version of each permission system for the lifetime of
+// the provider, so that planning many roles of the same system reads it only once
+type supportedAPIsCache struct {
+	mu      sync.Mutex
+	entries map[string]spiceDBVersionAPIs
+	// fetches dedupes concurrent reads of the same permission system
+	fetches singleflight.Group
+}
+
+func newSupportedAPIsCache() *supportedAPIsCache {
+	return &supportedAPIsCache{
+		entries: make(map[string]spiceDBVersionAPIs),
+	}
+}
+
+// get returns the SpiceDB version of a permission system, reading it from the API on first use.
+// The mutex is only held for the map access, concurrent reads of the same system share one request.
+func (c *supportedAPIsCache) get(ctx context.Context, cloudClient *client.CloudClient, permissionsSystemID string) (spiceDBVersionAPIs, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[permissionsSystemID]
+	c.mu.Unlock()
+	if ok {
+		return entry, nil
+	}
+
+	result, err, _ := c.fetches.Do(permissionsSystemID, func() (any, error) {
+		permissionsSystemWithETag, err := cloudClient.GetPermissionsSystem(ctx, permissionsSystemID)
+		if err != nil {
+			return spiceDBVersionAPIs{}, err
+		}
+
+		currentVersion := permissionsSystemWithETag.PermissionsSystem.Version.CurrentVersion
+		entry := spiceDBVersionAPIs{
+			Version:       currentVersion.Version,
+			SupportedAPIs: currentVersion.SupportedAPIs,
+		}
+
+		c.mu.Lock()
+		c.entries[permissionsSystemID] = entry
+		c.mu.Unlock()
+		return entry, nil
+	})
+	if err != nil {
+		return spiceDBVersionAPIs{}, err
+	}
+	return result.(spiceDBVersionAPIs), nil
+}
+
+// unsupportedPermissionKeys returns the permission keys whose API method is not in supportedAPIs.
+// Supported APIs may be listed as full keys (authzed.v1/CheckPermission), fully qualified methods
+// (authzed.api.v1.PermissionsService.CheckPermission) or bare method names. Nothing is reported
+// when the version does not list its supported APIs.
+func unsupportedPermissionKeys(keys []string, supportedAPIs []string) []string {
+	if len(supportedAPIs) == 0 {
+		return nil
+	}
+
+	supported := make(map[string]bool, len(supportedAPIs))
+	for _, api := range supportedAPIs {
+		supported[api] = true
+		supported[apiMethodName(api)] = true
+	}
+
+	var unsupported []string
+	for _, key := range keys {
+		if supported[key] || supported[apiMethodName(key)] {
+			continue
+		}
+		unsupported = append(unsupported, key)
+	}
+	return unsupported
+}
+
+// apiMethodName returns the method name of an API key, e.g. CheckPermission for authzed.v1/CheckPermission
+func apiMethodName(api string) string {
+	if i := strings.LastIndexAny(api, "/."); i >= 0 {
+		return api[i+1:]
+	}
+	return api
+}
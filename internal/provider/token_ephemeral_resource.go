@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/client"
+	"terraform-provider-authzed/internal/models"
+	"terraform-provider-authzed/internal/provider/concurrency"
+	"terraform-provider-authzed/internal/provider/pslanes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &TokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &TokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &TokenEphemeralResource{}
+)
+
+// tokenEphemeralPrivateKey is the private data key holding the identifiers Close needs to delete the token
+const tokenEphemeralPrivateKey = "token"
+
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &TokenEphemeralResource{}
+}
+
+// TokenEphemeralResource creates a service account token when opened and deletes it when closed.
+// The secret is only ever returned as an ephemeral value and never written to plan or state.
+type TokenEphemeralResource struct {
+	client  *client.CloudClient
+	psLanes *pslanes.PSLanes
+	limits  *concurrency.Limits
+}
+
+type TokenEphemeralResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	ServiceAccountID    types.String `tfsdk:"service_account_id"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	Hash                types.String `tfsdk:"hash"`
+	PlainText           types.String `tfsdk:"plain_text"`
+}
+
+// tokenEphemeralPrivateData identifies the token created by Open
+type tokenEphemeralPrivateData struct {
+	PermissionsSystemID string `json:"permission_system_id"`
+	ServiceAccountID    string `json:"service_account_id"`
+	TokenID             string `json:"token_id"`
+}
+
+func (r *TokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+func (r *TokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived service account token that is deleted once Terraform no longer needs it. The token is never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The globally unique ID for this token",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the token",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The human-supplied description of the token",
+				Optional:    true,
+			},
+			"permission_system_id": schema.StringAttribute{
+				Description: "The globally unique ID for the permission system",
+				Required:    true,
+			},
+			"service_account_id": schema.StringAttribute{
+				Description: "The globally unique ID for the containing service account",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the token was created",
+				Computed:    true,
+			},
+			"hash": schema.StringAttribute{
+				Description: "The SHA256 hash of the token",
+				Computed:    true,
+			},
+			"plain_text": schema.StringAttribute{
+				Description: "The token value",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*CloudProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *CloudProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.psLanes = providerData.PSLanes
+	r.limits = providerData.Limits
+}
+
+// Open creates the token and returns its secret
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := &models.TokenRequest{
+		Name:                data.Name.ValueString(),
+		Description:         data.Description.ValueString(),
+		PermissionsSystemID: data.PermissionsSystemID.ValueString(),
+		ServiceAccountID:    data.ServiceAccountID.ValueString(),
+		ReturnPlainText:     true,
+	}
+
+	// Create the token in the adaptive create lane of its Permission System, which narrows on FGAM conflicts
+	var createdTokenWithETag *client.TokenWithETag
+	err := r.limits.With(ctx, concurrency.Tokens, func() error {
+		return r.psLanes.WithCreateLane(ctx, token.PermissionsSystemID, func() error {
+			ct, cerr := r.client.CreateToken(ctx, token)
+			if cerr != nil {
+				return cerr
+			}
+			createdTokenWithETag = ct
+			return nil
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating token",
+			fmt.Sprintf("Unable to create token: %v", err),
+		)
+		return
+	}
+
+	createdToken := createdTokenWithETag.Token
+
+	// Tokens recovered after an ambiguous create have no secret. Terraform does not call Close when
+	// Open fails, so delete the token here rather than leaving an unused credential behind.
+	if createdToken.Secret == "" {
+		deleteErr := r.deleteToken(ctx, token.PermissionsSystemID, token.ServiceAccountID, createdToken.ID)
+		if deleteErr != nil {
+			resp.Diagnostics.AddError(
+				"Error creating token",
+				fmt.Sprintf("The API did not return the value of token %s, and it could not be deleted, it must be deleted manually: %v", createdToken.ID, deleteErr),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error creating token",
+			fmt.Sprintf("The API did not return the value of token %s, so it was deleted", createdToken.ID),
+		)
+		return
+	}
+
+	private, err := json.Marshal(tokenEphemeralPrivateData{
+		PermissionsSystemID: createdToken.PermissionsSystemID,
+		ServiceAccountID:    createdToken.ServiceAccountID,
+		TokenID:             createdToken.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating token", fmt.Sprintf("Unable to encode private data: %v", err))
+		r.discardToken(ctx, createdToken, &resp.Diagnostics)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, tokenEphemeralPrivateKey, private)...)
+	if resp.Diagnostics.HasError() {
+		r.discardToken(ctx, createdToken, &resp.Diagnostics)
+		return
+	}
+
+	data.ID = types.StringValue(createdToken.ID)
+	data.Description = types.StringValue(createdToken.Description)
+	data.CreatedAt = types.StringValue(createdToken.CreatedAt)
+	data.Hash = types.StringValue(createdToken.Hash)
+	data.PlainText = types.StringValue(createdToken.Secret)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		r.discardToken(ctx, createdToken, &resp.Diagnostics)
+	}
+}
+
+// Close deletes the token created by Open
+func (r *TokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, tokenEphemeralPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(private) == 0 {
+		return
+	}
+
+	var data tokenEphemeralPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Error deleting token", fmt.Sprintf("Unable to decode private data: %v", err))
+		return
+	}
+
+	err := r.deleteToken(ctx, data.PermissionsSystemID, data.ServiceAccountID, data.TokenID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting token",
+			fmt.Sprintf("Unable to delete token %s, it must be deleted manually: %v", data.TokenID, err),
+		)
+		return
+	}
+}
+
+// discardToken deletes a token created by a failed Open, since Terraform does not call Close for it
+func (r *TokenEphemeralResource) discardToken(ctx context.Context, token *models.TokenRequest, diags *diag.Diagnostics) {
+	if err := r.deleteToken(ctx, token.PermissionsSystemID, token.ServiceAccountID, token.ID); err != nil {
+		diags.AddError(
+			"Error deleting token",
+			fmt.Sprintf("Unable to delete token %s after the failed open, it must be deleted manually: %v", token.ID, err),
+		)
+	}
+}
+
+// deleteToken deletes a token created by Open
+func (r *TokenEphemeralResource) deleteToken(ctx context.Context, permissionSystemID, serviceAccountID, tokenID string) error {
+	// Delete the token in the adaptive delete lane of its Permission System, with 409 retry
+	return r.limits.With(ctx, concurrency.Tokens, func() error {
+		return r.psLanes.WithDeleteLane(ctx, permissionSystemID, func() error {
+			return pslanes.Retry409Delete(ctx, func() error {
+				return r.client.DeleteToken(permissionSystemID, serviceAccountID, tokenID)
+			})
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-authzed/internal/models"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &TokenResource{}
+	_ list.ListResourceWithConfigure = &TokenResource{}
+)
+
+func NewTokenListResource() list.ListResource {
+	return &TokenResource{}
+}
+
+// tokenListConfigModel is the configuration of token list blocks
+type tokenListConfigModel struct {
+	PermissionsSystemID types.String `tfsdk:"permission_system_id"`
+	ServiceAccountID    types.String `tfsdk:"service_account_id"`
+}
+
+func (r *TokenResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the tokens of a service account",
+		Attributes: map[string]listschema.Attribute{
+			"permission_system_id": listschema.StringAttribute{
+				Description: "The ID of the permission system",
+				Required:    true,
+			},
+			"service_account_id": listschema.StringAttribute{
+				Description: "The ID of the service account",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// List streams the tokens of a service account. Token values are only returned at creation, so
+// listed tokens never include plain_text.
+func (r *TokenResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config tokenListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tokens, err := r.client.ListTokens(config.PermissionsSystemID.ValueString(), config.ServiceAccountID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list tokens, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, tokens, func(token models.TokenRequest, result *list.ListResult) {
+		result.DisplayName = token.Name
+		result.Diagnostics.Append(setTokenIdentity(ctx, result.Identity, config.PermissionsSystemID, config.ServiceAccountID, types.StringValue(token.ID))...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(r.listResultResource(ctx, config, token, result)...)
+		}
+	})
+}
+
+// listResultResource sets the resource of a list result from a listed token
+func (r *TokenResource) listResultResource(ctx context.Context, config tokenListConfigModel, token models.TokenRequest, result *list.ListResult) diag.Diagnostics {
+	data := TokenResourceModel{
+		ID:                  types.StringValue(token.ID),
+		Name:                types.StringValue(token.Name),
+		Description:         types.StringValue(token.Description),
+		PermissionsSystemID: config.PermissionsSystemID,
+		ServiceAccountID:    config.ServiceAccountID,
+		CreatedAt:           types.StringValue(token.CreatedAt),
+		Creator:             types.StringValue(token.Creator),
+		UpdatedAt:           types.StringValue(token.UpdatedAt),
+		Updater:             types.StringValue(token.Updater),
+		Hash:                stringValueOrNull(token.Hash),
+		PlainText:           types.StringNull(),
+		// The list response carries no ETag, the next refresh stores it
+		ETag:             types.StringNull(),
+		RotateAfter:      types.StringNull(),
+		RotationTriggers: types.MapNull(types.StringType),
+		ExpiresAt:        types.StringNull(),
+	}
+
+	diags := result.Resource.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(result.Resource.Set(ctx, &data)...)
+	return diags
+}
@@ -11,13 +11,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-authzed/internal/client"
 	"terraform-provider-authzed/internal/models"
+	"terraform-provider-authzed/internal/provider/concurrency"
 	"terraform-provider-authzed/internal/provider/pslanes"
 )
 
@@ -25,6 +29,8 @@ import (
 var (
 	_ resource.Resource                = &TokenResource{}
 	_ resource.ResourceWithImportState = &TokenResource{}
+	_ resource.ResourceWithIdentity    = &TokenResource{}
+	_ resource.ResourceWithModifyPlan  = &TokenResource{}
 )
 
 func NewTokenResource() resource.Resource {
@@ -34,6 +40,7 @@ func NewTokenResource() resource.Resource {
 type TokenResource struct {
 	client  *client.CloudClient
 	psLanes *pslanes.PSLanes
+	limits  *concurrency.Limits
 }
 
 type TokenResourceModel struct {
@@ -49,6 +56,9 @@ type TokenResourceModel struct {
 	Hash                types.String   `tfsdk:"hash"`
 	PlainText           types.String   `tfsdk:"plain_text"`
 	ETag                types.String   `tfsdk:"etag"`
+	RotateAfter         types.String   `tfsdk:"rotate_after"`
+	RotationTriggers    types.Map      `tfsdk:"rotation_triggers"`
+	ExpiresAt           types.String   `tfsdk:"expires_at"`
 	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -126,6 +136,26 @@ func (r *TokenResource) Schema(ctx context.Context, _ resource.SchemaRequest, re
 				Computed:    true,
 				Description: "Version identifier used to prevent conflicts from concurrent updates",
 			},
+			"rotate_after": schema.StringAttribute{
+				Description: "Duration after creation at which the token is replaced with a new one, such as 720h. " +
+					"Use together with create_before_destroy so that the new token exists before the old one is deleted",
+				Optional: true,
+				Validators: []validator.String{
+					validDuration(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, replace the token with a new one",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The timestamp after which the token is replaced, i.e. created_at plus rotate_after. Null when rotate_after is not set",
+				Computed:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -152,6 +182,7 @@ func (r *TokenResource) Configure(_ context.Context, req resource.ConfigureReque
 
 	r.client = providerData.Client
 	r.psLanes = providerData.PSLanes
+	r.limits = providerData.Limits
 }
 
 func (r *TokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -182,15 +213,17 @@ func (r *TokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		ReturnPlainText:     true, // Always request plain text during creation
 	}
 
-	// Serialize token create per Permission System to avoid FGAM conflicts
+	// Create the token in the adaptive create lane of its Permission System, which narrows on FGAM conflicts
 	var createdTokenWithETag *client.TokenWithETag
-	err := r.psLanes.WithCreateLane(createCtx, token.PermissionsSystemID, func() error {
-		ct, cerr := r.client.CreateToken(createCtx, token)
-		if cerr != nil {
-			return cerr
-		}
-		createdTokenWithETag = ct
-		return nil
+	err := r.limits.With(createCtx, concurrency.Tokens, func() error {
+		return r.psLanes.WithCreateLane(createCtx, token.PermissionsSystemID, func() error {
+			ct, cerr := r.client.CreateToken(createCtx, token)
+			if cerr != nil {
+				return cerr
+			}
+			createdTokenWithETag = ct
+			return nil
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -220,9 +253,16 @@ func (r *TokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		plan.Hash = types.StringValue(createdTokenWithETag.Token.Hash)
 	}
 
+	expiresAt, err := tokenExpiresAt(plan.CreatedAt, plan.RotateAfter)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Compute Token Expiry", err.Error())
+	}
+	plan.ExpiresAt = expiresAt
+
 	// Save data into Terraform state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setTokenIdentity(ctx, resp.Identity, plan.PermissionsSystemID, plan.ServiceAccountID, plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -277,8 +317,15 @@ func (r *TokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.Hash = types.StringValue(tokenWithETag.Token.Hash)
 	}
 
+	expiresAt, err := tokenExpiresAt(state.CreatedAt, state.RotateAfter)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Compute Token Expiry", err.Error())
+	}
+	state.ExpiresAt = expiresAt
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setTokenIdentity(ctx, resp.Identity, state.PermissionsSystemID, state.ServiceAccountID, state.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -319,7 +366,12 @@ func (r *TokenResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Use the ETag from state for optimistic concurrency control
-	updatedTokenWithETag, err := r.client.UpdateToken(ctx, token, state.ETag.ValueString())
+	var updatedTokenWithETag *client.TokenWithETag
+	err := r.limits.With(ctx, concurrency.Tokens, func() error {
+		var updateErr error
+		updatedTokenWithETag, updateErr = r.client.UpdateToken(ctx, token, state.ETag.ValueString())
+		return updateErr
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating token",
@@ -338,9 +390,50 @@ func (r *TokenResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.PlainText = state.PlainText
 	plan.ETag = types.StringValue(updatedTokenWithETag.ETag)
 
+	expiresAt, err := tokenExpiresAt(plan.CreatedAt, plan.RotateAfter)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Compute Token Expiry", err.Error())
+	}
+	plan.ExpiresAt = expiresAt
+
 	// Save updated data into Terraform state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setTokenIdentity(ctx, resp.Identity, plan.PermissionsSystemID, plan.ServiceAccountID, plan.ID)...)
+}
+
+// ModifyPlan plans the expiry of the token, and replaces it once rotate_after has passed since its creation
+func (r *TokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan TokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, err := tokenExpiresAt(state.CreatedAt, plan.RotateAfter)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Compute Token Expiry", err.Error())
+		return
+	}
+
+	if tokenRotationDue(expiresAt) {
+		tflog.Info(ctx, "Token is due for rotation", map[string]any{
+			"token_id":   state.ID.ValueString(),
+			"expires_at": expiresAt.ValueString(),
+		})
+		// Replacement is only planned when the value of the path changes, so expires_at becomes unknown
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), expiresAt)...)
 }
 
 func (r *TokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -364,14 +457,16 @@ func (r *TokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	permissionSystemID := state.PermissionsSystemID.ValueString()
 
-	// Serialize token deletion per Permission System with 409 retry
-	err := r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
-		return pslanes.Retry409Delete(deleteCtx, func() error {
-			return r.client.DeleteToken(
-				permissionSystemID,
-				state.ServiceAccountID.ValueString(),
-				state.ID.ValueString(),
-			)
+	// Delete the token in the adaptive delete lane of its Permission System, with 409 retry
+	err := r.limits.With(deleteCtx, concurrency.Tokens, func() error {
+		return r.psLanes.WithDeleteLane(deleteCtx, permissionSystemID, func() error {
+			return pslanes.Retry409Delete(deleteCtx, func() error {
+				return r.client.DeleteToken(
+					permissionSystemID,
+					state.ServiceAccountID.ValueString(),
+					state.ID.ValueString(),
+				)
+			})
 		})
 	})
 	if err != nil {
@@ -380,8 +475,19 @@ func (r *TokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// IdentitySchema defines the identity of the token, which import blocks can use instead of an import ID
+func (r *TokenResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tokenIdentitySchema()
+}
+
 // ImportState handles importing an existing token into Terraform state
 func (r *TokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import blocks may identify the token with an identity instead of an import ID
+	if req.ID == "" {
+		importTokenIdentity(ctx, req, resp)
+		return
+	}
+
 	idParts := strings.Split(req.ID, ":")
 	if len(idParts) != 3 {
 		resp.Diagnostics.AddError(
@@ -399,6 +505,7 @@ func (r *TokenResource) ImportState(ctx context.Context, req resource.ImportStat
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_system_id"), permissionSystemID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_account_id"), serviceAccountID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tokenID)...)
+	resp.Diagnostics.Append(setTokenIdentity(ctx, resp.Identity, types.StringValue(permissionSystemID), types.StringValue(serviceAccountID), types.StringValue(tokenID))...)
 
 	// Terraform automatically calls Read to fetch the rest of the attributes
 }
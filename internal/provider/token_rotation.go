@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tokenNow returns the current time, and is replaced in tests
+var tokenNow = time.Now
+
+// tokenExpiresAt returns the time a token created at createdAt is due for rotation, a null value when
+// the token is not rotated, or an unknown value when rotate_after or the creation time is not known yet
+func tokenExpiresAt(createdAt, rotateAfter types.String) (types.String, error) {
+	if rotateAfter.IsNull() {
+		return types.StringNull(), nil
+	}
+	if rotateAfter.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	if createdAt.IsNull() || createdAt.IsUnknown() || createdAt.ValueString() == "" {
+		return types.StringUnknown(), nil
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt.ValueString())
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("invalid created_at %q: %w", createdAt.ValueString(), err)
+	}
+	duration, err := time.ParseDuration(rotateAfter.ValueString())
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("invalid rotate_after %q: %w", rotateAfter.ValueString(), err)
+	}
+
+	return types.StringValue(created.Add(duration).UTC().Format(time.RFC3339)), nil
+}
+
+// tokenRotationDue reports whether a token that expires at expiresAt must be replaced
+func tokenRotationDue(expiresAt types.String) bool {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return false
+	}
+
+	expires, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return false
+	}
+	return !tokenNow().Before(expires)
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a positive Go duration, such as 720h or 90m
+type durationValidator struct{}
+
+func validDuration() validator.String {
+	return durationValidator{}
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration, such as 720h or 90m"
+}
+
+func (v durationValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a positive duration, such as `720h` or `90m`"
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%q is not a positive duration. Use a number with a unit of h, m or s, such as 720h for 30 days.", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTokenExpiresAt(t *testing.T) {
+	testCases := []struct {
+		name        string
+		createdAt   types.String
+		rotateAfter types.String
+		want        types.String
+		wantErr     bool
+	}{
+		{name: "NoRotation", createdAt: types.StringValue("2026-01-01T00:00:00Z"), rotateAfter: types.StringNull(), want: types.StringNull()},
+		{name: "UnknownRotation", createdAt: types.StringValue("2026-01-01T00:00:00Z"), rotateAfter: types.StringUnknown(), want: types.StringUnknown()},
+		{name: "NotCreated", createdAt: types.StringUnknown(), rotateAfter: types.StringValue("720h"), want: types.StringUnknown()},
+		{name: "Rotation", createdAt: types.StringValue("2026-01-01T00:00:00Z"), rotateAfter: types.StringValue("720h"), want: types.StringValue("2026-01-31T00:00:00Z")},
+		{name: "FractionalSeconds", createdAt: types.StringValue("2026-01-01T10:00:00.123456Z"), rotateAfter: types.StringValue("90m"), want: types.StringValue("2026-01-01T11:30:00Z")},
+		{name: "InvalidCreatedAt", createdAt: types.StringValue("yesterday"), rotateAfter: types.StringValue("1h"), want: types.StringNull(), wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tokenExpiresAt(tc.createdAt, tc.rotateAfter)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error %v, got %v", tc.wantErr, err)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("Expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTokenRotationDue(t *testing.T) {
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	defer func(original func() time.Time) { tokenNow = original }(tokenNow)
+	tokenNow = func() time.Time { return now }
+
+	if tokenRotationDue(types.StringNull()) {
+		t.Error("Expected a token without expiry not to be rotated")
+	}
+	if tokenRotationDue(types.StringValue("2026-02-01T00:00:01Z")) {
+		t.Error("Expected a token expiring in the future not to be rotated")
+	}
+	if !tokenRotationDue(types.StringValue("2026-02-01T00:00:00Z")) {
+		t.Error("Expected an expired token to be rotated")
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	for value, valid := range map[string]bool{"720h": true, "1h30m": true, "30d": false, "0s": false, "-1h": false} {
+		resp := &validator.StringResponse{}
+		validDuration().ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("rotate_after"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q valid=%v, got %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestTokenResource_ModifyPlanRotation(t *testing.T) {
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	defer func(original func() time.Time) { tokenNow = original }(tokenNow)
+	tokenNow = func() time.Time { return now }
+
+	ctx := context.Background()
+	providerServer, err := testAccProtoV6ProviderFactories["authzed"]()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	tokenSchema := schemaResp.ResourceSchemas["authzed_token"]
+
+	config := map[string]tftypes.Value{
+		"name":                 tftypes.NewValue(tftypes.String, "ci"),
+		"permission_system_id": tftypes.NewValue(tftypes.String, "ps-123"),
+		"service_account_id":   tftypes.NewValue(tftypes.String, "asa-123"),
+		"rotate_after":         tftypes.NewValue(tftypes.String, "720h"),
+	}
+	state := func(createdAt string) map[string]tftypes.Value {
+		values := map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "atk-123"),
+			"description": tftypes.NewValue(tftypes.String, ""),
+			"created_at":  tftypes.NewValue(tftypes.String, createdAt),
+			"creator":     tftypes.NewValue(tftypes.String, "someone"),
+			"updated_at":  tftypes.NewValue(tftypes.String, createdAt),
+			"updater":     tftypes.NewValue(tftypes.String, "someone"),
+			"hash":        tftypes.NewValue(tftypes.String, "abc"),
+			"plain_text":  tftypes.NewValue(tftypes.String, "sdbst_secret"),
+			"etag":        tftypes.NewValue(tftypes.String, "etag-1"),
+		}
+		for name, value := range config {
+			values[name] = value
+		}
+		return values
+	}
+
+	testCases := []struct {
+		name        string
+		createdAt   string
+		wantReplace bool
+	}{
+		{name: "NotDue", createdAt: "2026-01-15T00:00:00Z", wantReplace: false},
+		{name: "Due", createdAt: "2026-01-01T00:00:00Z", wantReplace: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prior := testDynamicValue(t, tokenSchema, state(tc.createdAt))
+			resp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "authzed_token",
+				PriorState:       prior,
+				ProposedNewState: prior,
+				Config:           testDynamicValue(t, tokenSchema, config),
+			})
+			if err != nil || len(resp.Diagnostics) > 0 {
+				t.Fatalf("Unexpected error planning: %v %v", err, resp.Diagnostics)
+			}
+
+			replace := false
+			for _, p := range resp.RequiresReplace {
+				if p.Equal(tftypes.NewAttributePath().WithAttributeName("expires_at")) {
+					replace = true
+				}
+			}
+			if replace != tc.wantReplace {
+				t.Errorf("Expected replacement %v, got %v", tc.wantReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
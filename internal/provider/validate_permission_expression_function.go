@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &validatePermissionExpressionFunction{}
+
+func NewValidatePermissionExpressionFunction() function.Function {
+	return &validatePermissionExpressionFunction{}
+}
+
+// validatePermissionExpressionFunction checks a role permission expression without calling the API
+type validatePermissionExpressionFunction struct{}
+
+func (f *validatePermissionExpressionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_permission_expression"
+}
+
+func (f *validatePermissionExpressionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a role permission expression",
+		Description: "Parses and type-checks a CEL expression used as the value of a role permission. " +
+			"Returns true if the expression is valid, and fails with the position of every syntax or type error otherwise. " +
+			"An empty expression grants the permission unconditionally and is valid.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "expression",
+				Description: "The permission expression to validate, e.g. CheckPermissionRequest.permission == \"view\"",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *validatePermissionExpressionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := checkPermissionExpression(expression); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid permission expression:\n\n%s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, true))
+}
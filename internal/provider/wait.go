@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
 	"terraform-provider-authzed/internal/client"
 )
 
@@ -92,3 +95,91 @@ func waitForRoleExists(ctx context.Context, client *client.CloudClient, psID, ro
 		return true, nil // Found!
 	})
 }
+
+// permissionSystemPollInterval is how often the permission system state is polled while waiting for an upgrade
+var permissionSystemPollInterval = 10 * time.Second
+
+// permissionSystemUpgradeStartGracePeriod is how long to wait for an expected upgrade to start. The
+// available update may be stale or not rolled out on the new channel, so RUNNING is accepted afterwards.
+var permissionSystemUpgradeStartGracePeriod = 2 * time.Minute
+
+// permissionSystemUpgradeError is returned when a permission system reports UPGRADE_ERROR while waiting
+type permissionSystemUpgradeError struct {
+	Status  string
+	Message string
+}
+
+func (e *permissionSystemUpgradeError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("permission system reported status %s", e.Status)
+	}
+	return fmt.Sprintf("permission system reported status %s: %s", e.Status, e.Message)
+}
+
+// permissionSystemUpgrade describes the upgrade that waitForPermissionSystemRunning waits for
+type permissionSystemUpgrade struct {
+	// TargetVersion is the version the permission system must run, or empty when it follows its channel
+	TargetVersion string
+	// PreviousVersion is the version the permission system ran before the update
+	PreviousVersion string
+	// Expected is set when the update is expected to upgrade a permission system that follows its channel.
+	// RUNNING is then only accepted once the upgrade was observed, as the first polls may still see the
+	// permission system running the previous version before the upgrade starts, or once the upgrade
+	// did not start within permissionSystemUpgradeStartGracePeriod.
+	Expected bool
+}
+
+// waitForPermissionSystemRunning polls the permission system until it reports RUNNING, and
+// when a target version is set, until it runs that version. Transitional states such as UPGRADING
+// and MODIFYING are waited out; UPGRADE_ERROR fails immediately.
+func waitForPermissionSystemRunning(ctx context.Context, client *client.CloudClient, psID string, upgrade permissionSystemUpgrade) (*client.PermissionsSystemWithETag, error) {
+	upgradeObserved := false
+	gracePeriodEnd := time.Now().Add(permissionSystemUpgradeStartGracePeriod)
+	for {
+		permissionsSystemWithETag, err := client.GetPermissionsSystem(ctx, psID)
+		if err != nil {
+			return nil, err
+		}
+
+		state := permissionsSystemWithETag.PermissionsSystem.SystemState
+		currentVersion := permissionsSystemWithETag.PermissionsSystem.Version.CurrentVersion.Version
+		if state.Status != "RUNNING" || currentVersion != upgrade.PreviousVersion {
+			upgradeObserved = true
+		}
+
+		switch state.Status {
+		case "UPGRADE_ERROR":
+			return nil, &permissionSystemUpgradeError{Status: state.Status, Message: state.Message}
+		case "RUNNING":
+			switch {
+			case upgrade.TargetVersion != "":
+				if currentVersion == upgrade.TargetVersion {
+					return permissionsSystemWithETag, nil
+				}
+			case !upgrade.Expected || upgradeObserved:
+				return permissionsSystemWithETag, nil
+			case !time.Now().Before(gracePeriodEnd):
+				tflog.Debug(ctx, "Permission system upgrade did not start, accepting its current version", map[string]any{
+					"permission_system_id": psID,
+					"current_version":      currentVersion,
+				})
+				return permissionsSystemWithETag, nil
+			}
+		}
+
+		tflog.Debug(ctx, "Waiting for permission system to be running", map[string]any{
+			"permission_system_id": psID,
+			"status":               state.Status,
+			"current_version":      currentVersion,
+			"target_version":       upgrade.TargetVersion,
+		})
+
+		timer := time.NewTimer(permissionSystemPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("timed out waiting for permission system %s to be running (last status %s): %w", psID, state.Status, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
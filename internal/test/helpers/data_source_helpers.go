@@ -40,8 +40,8 @@ resource "authzed_role" "test" {
   permission_system_id = %q
   description         = "Test role for policy data source"
   permissions = {
-    "document:read" = "true"
-    "document:write" = "request.user.id == resource.owner_id"
+    "authzed.v1/ReadSchema"      = ""
+    "authzed.v1/CheckPermission" = "CheckPermissionRequest.permission == \"read\""
   }
 }
 
@@ -88,8 +88,8 @@ resource "authzed_role" "test" {
   permission_system_id = %q
   description         = "Test role for data source"
   permissions = {
-    "document:read" = "true"
-    "document:write" = "request.user.id == resource.owner_id"
+    "authzed.v1/ReadSchema"      = ""
+    "authzed.v1/CheckPermission" = "CheckPermissionRequest.permission == \"read\""
   }
 }
 
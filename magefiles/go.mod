@@ -4,6 +4,7 @@ go 1.24.2
 
 require (
 	github.com/magefile/mage v1.15.0
+	gopkg.in/yaml.v3 v3.0.1
 	mvdan.cc/gofumpt v0.8.0
 )
 
@@ -189,7 +190,6 @@ require (
 	google.golang.org/protobuf v1.36.5 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	honnef.co/go/tools v0.6.1 // indirect
 	mvdan.cc/unparam v0.0.0-20240528143540-8a5130ca722f // indirect
 )
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"go/format"
 	"io"
 	"net/http"
 	"os"
+	"sort"
 
 	"github.com/magefile/mage/mg"
 	"github.com/magefile/mage/sh"
+	"gopkg.in/yaml.v3"
 )
 
 type OpenAPI mg.Namespace
@@ -77,5 +81,60 @@ func (OpenAPI) Update() error {
 		fmt.Println("No changes detected in the OpenAPI spec.")
 	}
 
+	return OpenAPI{}.Generate()
+}
+
+// Generate regenerates the Go code derived from the OpenAPI specification
+func (OpenAPI) Generate() error {
+	specFile := "openapi-spec.yaml"
+	outputFile := "internal/models/permission_expr_map_gen.go"
+
+	data, err := os.ReadFile(specFile)
+	if err != nil {
+		return fmt.Errorf("failed to read OpenAPI spec: %w", err)
+	}
+
+	var spec struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]any `yaml:"properties"`
+			} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return fmt.Errorf("failed to parse OpenAPI spec: %w", err)
+	}
+
+	permissionExprMap, ok := spec.Components.Schemas["PermissionExprMap"]
+	if !ok || len(permissionExprMap.Properties) == 0 {
+		return fmt.Errorf("PermissionExprMap schema not found in %s", specFile)
+	}
+
+	keys := make([]string, 0, len(permissionExprMap.Properties))
+	for key := range permissionExprMap.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by mage openapi:generate from %s. DO NOT EDIT.\n\n", specFile)
+	buf.WriteString("package models\n\n")
+	buf.WriteString("// PermissionExprMapKeys are the API methods a role can grant permissions on\n")
+	buf.WriteString("var PermissionExprMapKeys = []string{\n")
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "\t%q,\n", key)
+	}
+	buf.WriteString("}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	if err := os.WriteFile(outputFile, formatted, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputFile, err)
+	}
+
+	fmt.Printf("Generated %s with %d permission keys\n", outputFile, len(keys))
 	return nil
 }
@@ -18,6 +18,13 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(context.Background(), os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	var debug bool
 	flag.BoolVar(&debug, "debug", false, "run provider with debugger support")
 	flag.Parse()